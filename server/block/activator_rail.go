@@ -0,0 +1,96 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// ActivatorRail is a rail that activates minecarts riding over it while powered by redstone. Activated minecarts
+// eject their riders, TNT minecarts are primed and hopper minecarts stop collecting items. Power is passed on
+// through a line of up to eight connected activator rails.
+type ActivatorRail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail. Activator rails cannot be curved.
+	Shape RailShape
+	// Powered is whether the rail is powered by redstone.
+	Powered bool
+}
+
+// RailShape ...
+func (r ActivatorRail) RailShape() RailShape {
+	return r.Shape
+}
+
+// WithRailShape ...
+func (r ActivatorRail) WithRailShape(s RailShape) Track {
+	r.Shape = s
+	return r
+}
+
+// UseOnBlock ...
+func (r ActivatorRail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, placed := placeTrack(pos, face, w, user, ctx, ActivatorRail{Shape: r.Shape})
+	if placed {
+		updateTrackPower[ActivatorRail](pos, w)
+	}
+	return placed
+}
+
+// NeighbourUpdateTick ...
+func (r ActivatorRail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	breakUnsupportedTrack(pos, w, ActivatorRail{})
+	updateTrackPower[ActivatorRail](pos, w)
+}
+
+// RedstoneUpdate ...
+func (r ActivatorRail) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	updateTrackPower[ActivatorRail](pos, w)
+}
+
+// HasLiquidDrops ...
+func (ActivatorRail) HasLiquidDrops() bool {
+	return true
+}
+
+// SideClosed ...
+func (ActivatorRail) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (r ActivatorRail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(ActivatorRail{}))
+}
+
+// EncodeItem ...
+func (ActivatorRail) EncodeItem() (name string, meta int16) {
+	return "minecraft:activator_rail", 0
+}
+
+// EncodeBlock ...
+func (r ActivatorRail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:activator_rail", map[string]any{"rail_direction": int32(r.Shape.Uint8()), "rail_data_bit": r.Powered}
+}
+
+// powered ...
+func (r ActivatorRail) powered() bool {
+	return r.Powered
+}
+
+// withPowered ...
+func (r ActivatorRail) withPowered(powered bool) poweredTrack {
+	r.Powered = powered
+	return r
+}
+
+// allActivatorRails ...
+func allActivatorRails() (rails []world.Block) {
+	for _, s := range StraightRailShapes() {
+		rails = append(rails, ActivatorRail{Shape: s}, ActivatorRail{Shape: s, Powered: true})
+	}
+	return
+}
@@ -1,10 +1,21 @@
 package block
 
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+)
+
 // Amethyst is a decorative block crafted from four amethyst shards.
 type Amethyst struct {
 	solid
 }
 
+// EntityStepOn ...
+func (Amethyst) EntityStepOn(pos cube.Pos, w *world.World, _ world.Entity) {
+	w.PlaySound(pos.Vec3Centre(), sound.AmethystChime{})
+}
+
 // BreakInfo ...
 func (a Amethyst) BreakInfo() BreakInfo {
 	return newBreakInfo(1.5, pickaxeHarvestable, pickaxeHarvestable, oneOf(a))
@@ -0,0 +1,128 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// AmethystCluster is a crystal that grows on budding amethyst. It grows through the stages of a small, medium and
+// large amethyst bud before becoming a fully grown amethyst cluster, which drops amethyst shards when mined.
+type AmethystCluster struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Stage is the growth stage of the cluster, ranging from 0 to 3. Stages 0, 1 and 2 are small, medium and large
+	// amethyst buds respectively, whereas stage 3 is a fully grown amethyst cluster.
+	Stage int
+	// Facing is the face that the cluster grows towards. The cluster is attached to the block on the opposite side.
+	Facing cube.Face
+}
+
+// maxAmethystStage is the growth stage of a fully grown amethyst cluster.
+const maxAmethystStage = 3
+
+// Model ...
+func (a AmethystCluster) Model() world.BlockModel {
+	switch a.Stage {
+	case 0:
+		return model.AmethystCluster{Facing: a.Facing, Height: 0.1875, Inset: 0.25}
+	case 1:
+		return model.AmethystCluster{Facing: a.Facing, Height: 0.25, Inset: 0.1875}
+	case 2:
+		return model.AmethystCluster{Facing: a.Facing, Height: 0.3125, Inset: 0.1875}
+	}
+	return model.AmethystCluster{Facing: a.Facing, Height: 0.4375, Inset: 0.1875}
+}
+
+// SideClosed ...
+func (AmethystCluster) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (a AmethystCluster) LightEmissionLevel() uint8 {
+	switch a.Stage {
+	case 0:
+		return 1
+	case 1:
+		return 2
+	case 2:
+		return 4
+	}
+	return 5
+}
+
+// NeighbourUpdateTick ...
+func (a AmethystCluster) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	attached := pos.Side(a.Facing.Opposite())
+	if !w.Block(attached).Model().FaceSolid(attached, a.Facing, w) {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: a})
+	}
+}
+
+// UseOnBlock ...
+func (a AmethystCluster) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, face, used := firstReplaceable(w, pos, face, a)
+	if !used {
+		return false
+	}
+	attached := pos.Side(face.Opposite())
+	if !w.Block(attached).Model().FaceSolid(attached, face, w) {
+		return false
+	}
+	a.Facing = face
+
+	place(w, pos, a, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (a AmethystCluster) BreakInfo() BreakInfo {
+	return newBreakInfo(1.5, alwaysHarvestable, pickaxeEffective, func(t item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if hasSilkTouch(enchantments) {
+			return []item.Stack{item.NewStack(AmethystCluster{Stage: a.Stage}, 1)}
+		}
+		if a.Stage != maxAmethystStage {
+			return nil
+		}
+		if t.ToolType() == item.TypePickaxe {
+			return []item.Stack{item.NewStack(item.AmethystShard{}, 4)}
+		}
+		return []item.Stack{item.NewStack(item.AmethystShard{}, 2)}
+	})
+}
+
+// EncodeItem ...
+func (a AmethystCluster) EncodeItem() (name string, meta int16) {
+	name, _ = a.EncodeBlock()
+	return name, 0
+}
+
+// EncodeBlock ...
+func (a AmethystCluster) EncodeBlock() (string, map[string]any) {
+	name := "amethyst_cluster"
+	switch a.Stage {
+	case 0:
+		name = "small_amethyst_bud"
+	case 1:
+		name = "medium_amethyst_bud"
+	case 2:
+		name = "large_amethyst_bud"
+	}
+	return "minecraft:" + name, map[string]any{"minecraft:block_face": a.Facing.String()}
+}
+
+// allAmethystClusters ...
+func allAmethystClusters() (clusters []world.Block) {
+	for stage := 0; stage <= maxAmethystStage; stage++ {
+		for _, f := range cube.Faces() {
+			clusters = append(clusters, AmethystCluster{Stage: stage, Facing: f})
+		}
+	}
+	return
+}
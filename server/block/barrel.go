@@ -65,6 +65,7 @@ func (b Barrel) WithName(a ...any) world.Item {
 func (b Barrel) open(w *world.World, pos cube.Pos) {
 	b.Open = true
 	w.PlaySound(pos.Vec3Centre(), sound.BarrelOpen{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerOpen(), nil)
 	w.SetBlock(pos, b, nil)
 }
 
@@ -72,6 +73,7 @@ func (b Barrel) open(w *world.World, pos cube.Pos) {
 func (b Barrel) close(w *world.World, pos cube.Pos) {
 	b.Open = false
 	w.PlaySound(pos.Vec3Centre(), sound.BarrelClose{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerClose(), nil)
 	w.SetBlock(pos, b, nil)
 }
 
@@ -0,0 +1,197 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Bed is a block that allows players to sleep through the night and set their spawn point. A bed consists of two
+// parts: the foot and the head.
+type Bed struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Colour is the colour of the bed.
+	Colour item.Colour
+	// Facing is the direction that the bed is facing, pointing from the foot to the head of the bed.
+	Facing cube.Direction
+	// Head is true if the bed is the head part of the bed.
+	Head bool
+	// Occupied is true if a player is currently sleeping in the bed. Only the head part of a bed is ever occupied.
+	Occupied bool
+}
+
+// HostileEntity is an entity that prevents players from sleeping in a bed while it is close to the bed.
+type HostileEntity interface {
+	world.Entity
+	// Hostile returns true if the entity is currently hostile.
+	Hostile() bool
+}
+
+// MaxCount always returns 1.
+func (Bed) MaxCount() int {
+	return 1
+}
+
+// Model ...
+func (Bed) Model() world.BlockModel {
+	return model.Bed{}
+}
+
+// SideClosed ...
+func (Bed) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (b Bed) BreakInfo() BreakInfo {
+	return newBreakInfo(0.2, alwaysHarvestable, nothingEffective, oneOf(Bed{Colour: b.Colour}))
+}
+
+// UseOnBlock ...
+func (b Bed) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	b.Facing = user.Rotation().Direction()
+	headPos := pos.Side(b.Facing.Face())
+	if !replaceableWith(w, headPos, b) {
+		return false
+	}
+	if !supportsBed(pos, w) || !supportsBed(headPos, w) {
+		return false
+	}
+
+	ctx.IgnoreBBox = true
+	place(w, pos, b, user, ctx)
+	place(w, headPos, Bed{Colour: b.Colour, Facing: b.Facing, Head: true}, user, ctx)
+	ctx.SubtractFromCount(1)
+	return placed(ctx)
+}
+
+// supportsBed checks if the block below the position passed is able to support a part of a bed.
+func supportsBed(pos cube.Pos, w *world.World) bool {
+	below := pos.Side(cube.FaceDown)
+	return w.Block(below).Model().FaceSolid(below, cube.FaceUp, w)
+}
+
+// NeighbourUpdateTick ...
+func (b Bed) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if _, _, ok := b.otherPart(pos, w); !ok {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	}
+}
+
+// Activate makes the user sleep in the bed if it is able to, setting its spawn point to the bed as a result. Beds
+// explode when used outside the Overworld.
+func (b Bed) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	s, ok := u.(world.Sleeper)
+	if !ok {
+		return false
+	}
+	headPos, head := pos, b
+	if !b.Head {
+		otherPos, other, ok := b.otherPart(pos, w)
+		if !ok {
+			return false
+		}
+		headPos, head = otherPos, other
+	}
+	footPos := headPos.Side(b.Facing.Opposite().Face())
+	if w.Dimension() != world.Overworld {
+		w.SetBlock(headPos, nil, nil)
+		w.SetBlock(footPos, nil, nil)
+		ExplosionConfig{Size: 5, SpawnFire: true}.Explode(w, headPos.Vec3Centre())
+		return true
+	}
+
+	if _, ok := s.Sleeping(); ok {
+		return true
+	}
+	if s.Position().Sub(headPos.Vec3Middle()).Len() > 3 && s.Position().Sub(footPos.Vec3Middle()).Len() > 3 {
+		s.SendTranslation("tile.bed.tooFar")
+		return true
+	}
+	if head.Occupied {
+		s.SendTranslation("tile.bed.occupied")
+		return true
+	}
+	if w.PlayerSpawn(s.UUID()) != headPos {
+		// Only the spawn point set last is used, so a spawn point previously set using a respawn anchor is reset.
+		if nether := w.PortalDestination(world.Nether); nether != w {
+			nether.SetPlayerSpawn(s.UUID(), nether.Spawn())
+		}
+		w.SetPlayerSpawn(s.UUID(), headPos)
+		if t, ok := s.(spawnBlockTracker); ok {
+			t.TrackSpawnBlock(headPos)
+		}
+		s.SendTranslation("tile.bed.respawnSet")
+	}
+
+	if t := w.Time() % 24000; (t < 12542 || t > 23459) && !w.ThunderingAt(headPos) {
+		s.SendTranslation("tile.bed.noSleep")
+		return true
+	}
+	for _, e := range w.EntitiesWithin(cube.Box(-8, -5, -8, 8, 5, 8).Translate(headPos.Vec3Middle()), nil) {
+		if h, ok := e.(HostileEntity); ok && h.Hostile() {
+			s.SendTranslation("tile.bed.notSafe")
+			return true
+		}
+	}
+
+	head.Occupied = true
+	w.SetBlock(headPos, head, nil)
+	s.Sleep(headPos)
+	return true
+}
+
+// otherPart returns the position and block of the other part of the bed. False is returned if the other part of
+// the bed is no longer present.
+func (b Bed) otherPart(pos cube.Pos, w *world.World) (cube.Pos, Bed, bool) {
+	face := b.Facing.Face()
+	if b.Head {
+		face = face.Opposite()
+	}
+	otherPos := pos.Side(face)
+	other, ok := w.Block(otherPos).(Bed)
+	return otherPos, other, ok && other.Head != b.Head && other.Facing == b.Facing
+}
+
+// EncodeItem ...
+func (b Bed) EncodeItem() (name string, meta int16) {
+	return "minecraft:bed", int16(b.Colour.Uint8())
+}
+
+// EncodeBlock ...
+func (b Bed) EncodeBlock() (name string, properties map[string]any) {
+	return "minecraft:bed", map[string]any{"direction": int32((b.Facing + 2) % 4), "head_piece_bit": b.Head, "occupied_bit": b.Occupied}
+}
+
+// EncodeNBT ...
+func (b Bed) EncodeNBT() map[string]any {
+	return map[string]any{"id": "Bed", "color": b.Colour.Uint8()}
+}
+
+// DecodeNBT ...
+func (b Bed) DecodeNBT(m map[string]any) any {
+	b.Colour = item.Colours()[nbtconv.Uint8(m, "color")]
+	return b
+}
+
+// allBeds returns all possible bed states.
+func allBeds() (beds []world.Block) {
+	for _, d := range cube.Directions() {
+		beds = append(beds, Bed{Facing: d})
+		beds = append(beds, Bed{Facing: d, Head: true})
+		beds = append(beds, Bed{Facing: d, Occupied: true})
+		beds = append(beds, Bed{Facing: d, Head: true, Occupied: true})
+	}
+	return
+}
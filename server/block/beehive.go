@@ -0,0 +1,374 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Beehive is a block that houses bees, which produce honey inside it. Honey may be harvested from a full beehive using
+// shears, which yields honeycomb, or using a glass bottle, which yields a honey bottle. Bee nests are the naturally
+// generated variant of beehives.
+type Beehive struct {
+	solid
+
+	// Nest specifies if the block is a naturally generated bee nest rather than a crafted beehive.
+	Nest bool
+	// Facing is the direction that the entrance of the beehive is facing.
+	Facing cube.Direction
+	// HoneyLevel is the level of honey in the beehive, ranging from 0 to 5. Honey may only be harvested from a
+	// beehive with a honey level of 5.
+	HoneyLevel int
+
+	// occupants holds the entities currently inside the beehive. It is shared between copies of the beehive, so
+	// that the time left of the occupants may be lowered without the beehive being set again.
+	occupants *beehiveOccupants
+}
+
+// beehiveOccupants holds the occupants of a beehive, which may be accessed concurrently when the beehive is saved.
+type beehiveOccupants struct {
+	mu sync.Mutex
+	o  []BeehiveOccupant
+}
+
+// newBeehiveOccupants returns a new beehiveOccupants holding the occupants passed, or nil if no occupants are
+// passed.
+func newBeehiveOccupants(o []BeehiveOccupant) *beehiveOccupants {
+	if len(o) == 0 {
+		return nil
+	}
+	return &beehiveOccupants{o: o}
+}
+
+// all returns a copy of all occupants.
+func (h *beehiveOccupants) all() []BeehiveOccupant {
+	if h == nil {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return slices.Clone(h.o)
+}
+
+// add adds the occupants passed.
+func (h *beehiveOccupants) add(o ...BeehiveOccupant) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.o = append(h.o, o...)
+}
+
+// tick lowers the time left of all occupants by the duration passed and returns true if any of them is ready to
+// leave.
+func (h *beehiveOccupants) tick(d time.Duration) (leaving bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i := range h.o {
+		h.o[i].TimeLeft -= d
+		leaving = leaving || h.o[i].TimeLeft <= 0
+	}
+	return leaving
+}
+
+// take removes all occupants for which the function passed returns true and returns them.
+func (h *beehiveOccupants) take(f func(o BeehiveOccupant) bool) (taken []BeehiveOccupant) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	remaining := h.o[:0]
+	for _, o := range h.o {
+		if f(o) {
+			taken = append(taken, o)
+			continue
+		}
+		remaining = append(remaining, o)
+	}
+	clear(h.o[len(remaining):])
+	h.o = remaining
+	return taken
+}
+
+// BeehiveOccupant is an entity stored inside a beehive, such as a bee.
+type BeehiveOccupant struct {
+	// Identifier is the saved identifier of the entity type, such as "minecraft:bee".
+	Identifier string
+	// Data holds the NBT data of the entity, as encoded by its world.SaveableEntityType.
+	Data map[string]any
+	// TimeLeft is the time left until the occupant leaves the beehive.
+	TimeLeft time.Duration
+	// Nectar specifies if the occupant entered the beehive carrying nectar. Occupants with nectar increase the
+	// honey level of the beehive when they leave it.
+	Nectar bool
+}
+
+// nectarCarrier represents an entity, such as a bee, that may carry nectar into a beehive.
+type nectarCarrier interface {
+	// HasNectar returns true if the entity is carrying nectar.
+	HasNectar() bool
+}
+
+// hiveDefender represents an entity, such as a bee, that becomes angry when the beehive it occupies is harvested or
+// broken without the beehive being pacified.
+type hiveDefender interface {
+	// Anger makes the entity angry at the target passed.
+	Anger(target world.Entity)
+}
+
+// beehiveCapacity is the maximum amount of occupants that a beehive may hold.
+const beehiveCapacity = 3
+
+// AddOccupant makes the entity passed enter the beehive at the position passed. The entity is removed from the world
+// and stored in the beehive for the duration passed. False is returned if the beehive is full or if the entity
+// cannot be saved.
+func (b Beehive) AddOccupant(pos cube.Pos, w *world.World, e world.Entity, stay time.Duration) bool {
+	t, ok := e.Type().(world.SaveableEntityType)
+	if !ok || len(b.Occupants()) >= beehiveCapacity {
+		return false
+	}
+	o := BeehiveOccupant{Identifier: t.EncodeEntity(), Data: t.EncodeNBT(e), TimeLeft: stay}
+	if n, ok := e.(nectarCarrier); ok {
+		o.Nectar = n.HasNectar()
+	}
+	w.RemoveEntity(e)
+	if b.occupants == nil {
+		b.occupants = newBeehiveOccupants([]BeehiveOccupant{o})
+		w.SetBlock(pos, b, nil)
+	} else {
+		b.occupants.add(o)
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.BeehiveEnter{})
+	return true
+}
+
+// Occupants returns the entities currently inside the beehive. A beehive may hold up to 3 occupants.
+func (b Beehive) Occupants() []BeehiveOccupant {
+	return b.occupants.all()
+}
+
+// Pacified checks if the beehive at the position passed is pacified by a lit campfire up to five blocks below it.
+// Occupants of a pacified beehive do not become angry when the beehive is harvested or broken.
+func (Beehive) Pacified(pos cube.Pos, w *world.World) bool {
+	for i := 1; i <= 5; i++ {
+		if c, ok := w.Block(pos.Sub(cube.Pos{0, i})).(Campfire); ok && !c.Extinguished {
+			return true
+		}
+	}
+	return false
+}
+
+// Activate ...
+func (b Beehive) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	if b.HoneyLevel < 5 {
+		return false
+	}
+	held, _ := u.HeldItems()
+	switch held.Item().(type) {
+	case item.Shears:
+		dropItem(w, item.NewStack(item.Honeycomb{}, 3), pos.Side(b.Facing.Face()).Vec3Centre())
+		w.PlaySound(pos.Vec3Centre(), sound.BeehiveShear{})
+		ctx.DamageItem(1)
+	case item.GlassBottle:
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = item.NewStack(item.HoneyBottle{}, 1)
+	default:
+		return false
+	}
+	b.HoneyLevel = 0
+	w.SetBlock(pos, b, nil)
+	if !b.Pacified(pos, w) {
+		b.releaseOccupants(pos, w, u)
+	}
+	return true
+}
+
+// Tick ...
+func (b Beehive) Tick(currentTick int64, pos cube.Pos, w *world.World) {
+	if b.occupants == nil || currentTick%20 != 0 {
+		return
+	}
+	if !b.occupants.tick(time.Second) || b.sheltered(pos, w) {
+		return
+	}
+	level := b.HoneyLevel
+	for _, o := range b.occupants.take(func(o BeehiveOccupant) bool { return o.TimeLeft <= 0 }) {
+		if !b.release(pos, w, o, nil) {
+			b.occupants.add(o)
+			continue
+		}
+		if o.Nectar && b.HoneyLevel < 5 {
+			b.HoneyLevel++
+		}
+	}
+	if b.HoneyLevel != level {
+		w.SetBlock(pos, b, nil)
+	}
+}
+
+// sheltered checks if the occupants of the beehive should stay inside, which is the case at night and while it is
+// raining.
+func (b Beehive) sheltered(pos cube.Pos, w *world.World) bool {
+	t := w.Time() % 24000
+	return (t >= 12000 && t < 23000) || w.RainingAt(pos.Side(cube.FaceUp))
+}
+
+// releaseOccupants releases all occupants of the beehive. If the target passed is not nil, the occupants released
+// become angry at the target.
+func (b Beehive) releaseOccupants(pos cube.Pos, w *world.World, target world.Entity) {
+	if b.occupants == nil {
+		return
+	}
+	for _, o := range b.occupants.take(func(BeehiveOccupant) bool { return true }) {
+		if !b.release(pos, w, o, target) {
+			b.occupants.add(o)
+		}
+	}
+}
+
+// release spawns the occupant passed in front of the beehive. False is returned if the entrance of the beehive is
+// obstructed or if the entity type of the occupant is not registered, in which case the occupant stays inside.
+func (b Beehive) release(pos cube.Pos, w *world.World, o BeehiveOccupant, target world.Entity) bool {
+	front := pos.Side(b.Facing.Face())
+	if len(w.Block(front).Model().BBox(front, w)) != 0 {
+		return false
+	}
+	t, ok := w.EntityRegistry().Lookup(o.Identifier)
+	if !ok {
+		return false
+	}
+	s, ok := t.(world.SaveableEntityType)
+	if !ok {
+		return false
+	}
+	data := make(map[string]any, len(o.Data)+1)
+	for k, v := range o.Data {
+		data[k] = v
+	}
+	data["Pos"] = nbtconv.Vec3ToFloat32Slice(front.Vec3Middle())
+	e := s.DecodeNBT(data)
+	if e == nil {
+		return false
+	}
+	w.AddEntity(e)
+	w.PlaySound(pos.Vec3Centre(), sound.BeehiveExit{})
+	if d, ok := e.(hiveDefender); ok && target != nil {
+		d.Anger(target)
+	}
+	return true
+}
+
+// UseOnBlock ...
+func (b Beehive) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	b = Beehive{Nest: b.Nest, Facing: user.Rotation().Direction().Opposite(), occupants: newBeehiveOccupants(b.Occupants())}
+
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (b Beehive) BreakInfo() BreakInfo {
+	hardness := 0.6
+	if b.Nest {
+		hardness = 0.3
+	}
+	return newBreakInfo(hardness, alwaysHarvestable, axeEffective, func(_ item.Tool, enchantments []item.Enchantment) []item.Stack {
+		if hasSilkTouch(enchantments) {
+			// Beehives broken using silk touch keep their occupants.
+			return []item.Stack{item.NewStack(Beehive{Nest: b.Nest, occupants: newBeehiveOccupants(b.Occupants())}, 1)}
+		}
+		if b.Nest {
+			return nil
+		}
+		return []item.Stack{item.NewStack(Beehive{}, 1)}
+	}).withBreakHandler(func(pos cube.Pos, w *world.World, u item.User) {
+		if u != nil {
+			if held, _ := u.HeldItems(); hasSilkTouch(held.Enchantments()) {
+				return
+			}
+		}
+		var target world.Entity
+		if u != nil && !b.Pacified(pos, w) {
+			target = u
+		}
+		b.releaseOccupants(pos, w, target)
+	})
+}
+
+// FlammabilityInfo ...
+func (Beehive) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(5, 20, true)
+}
+
+// FuelInfo ...
+func (Beehive) FuelInfo() item.FuelInfo {
+	return newFuelInfo(time.Second * 15)
+}
+
+// EncodeItem ...
+func (b Beehive) EncodeItem() (name string, meta int16) {
+	if b.Nest {
+		return "minecraft:bee_nest", 0
+	}
+	return "minecraft:beehive", 0
+}
+
+// EncodeBlock ...
+func (b Beehive) EncodeBlock() (string, map[string]any) {
+	name := "minecraft:beehive"
+	if b.Nest {
+		name = "minecraft:bee_nest"
+	}
+	return name, map[string]any{"direction": int32(horizontalDirection(b.Facing)), "honey_level": int32(b.HoneyLevel)}
+}
+
+// EncodeNBT ...
+func (b Beehive) EncodeNBT() map[string]any {
+	all := b.Occupants()
+	occupants := make([]any, 0, len(all))
+	for _, o := range all {
+		occupants = append(occupants, map[string]any{
+			"ActorIdentifier": o.Identifier,
+			"SaveData":        o.Data,
+			"TicksLeftToStay": int32(o.TimeLeft / (time.Millisecond * 50)),
+			"HasNectar":       boolByte(o.Nectar),
+		})
+	}
+	return map[string]any{"id": "Beehive", "Occupants": occupants, "ShouldSpawnBees": boolByte(false)}
+}
+
+// DecodeNBT ...
+func (b Beehive) DecodeNBT(data map[string]any) any {
+	var occupants []BeehiveOccupant
+	for _, v := range nbtconv.Slice(data, "Occupants") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		saveData, _ := m["SaveData"].(map[string]any)
+		occupants = append(occupants, BeehiveOccupant{
+			Identifier: nbtconv.String(m, "ActorIdentifier"),
+			Data:       saveData,
+			TimeLeft:   time.Duration(nbtconv.Int32(m, "TicksLeftToStay")) * time.Millisecond * 50,
+			Nectar:     nbtconv.Bool(m, "HasNectar"),
+		})
+	}
+	b.occupants = newBeehiveOccupants(occupants)
+	return b
+}
+
+// allBeehives ...
+func allBeehives() (beehives []world.Block) {
+	for _, d := range cube.Directions() {
+		for level := 0; level <= 5; level++ {
+			beehives = append(beehives, Beehive{Facing: d, HoneyLevel: level}, Beehive{Nest: true, Facing: d, HoneyLevel: level})
+		}
+	}
+	return
+}
@@ -67,6 +67,12 @@ type EntityLander interface {
 	EntityLand(pos cube.Pos, w *world.World, e world.Entity, distance *float64)
 }
 
+// EntityStepper represents a block that reacts to an entity taking a step on it.
+type EntityStepper interface {
+	// EntityStepOn is called when an entity takes a step on the block.
+	EntityStepOn(pos cube.Pos, w *world.World, e world.Entity)
+}
+
 // EntityInsider represents a block that reacts to an entity going inside its 1x1x1 axis
 // aligned bounding box.
 type EntityInsider interface {
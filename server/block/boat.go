@@ -0,0 +1,63 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// Boat is an item that may be placed on water or on the ground to create a boat of its wood type, which players
+// may ride. Crimson and warped wood have no boats.
+type Boat struct {
+	// Wood is the type of wood of the boat.
+	Wood WoodType
+	// Chest specifies if the boat has a chest, in which items may be stored. Boats with a chest have a single
+	// seat.
+	Chest bool
+}
+
+// UseOnBlock places the boat on top of the block clicked, or in it if the block is water.
+func (b Boat) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	spawn := pos.Side(face)
+	if _, ok := w.Liquid(pos); ok {
+		spawn = pos.Side(cube.FaceUp)
+	}
+	if _, ok := w.Block(spawn).Model().(model.Empty); !ok {
+		if _, ok := w.Liquid(spawn); !ok {
+			return false
+		}
+	}
+	create := w.EntityRegistry().Config().Boat
+	w.AddEntity(create(spawn.Vec3Middle(), user.Rotation().Yaw(), b.Wood, b.Chest))
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// MaxCount ...
+func (Boat) MaxCount() int {
+	return 1
+}
+
+// FuelInfo ...
+func (b Boat) FuelInfo() item.FuelInfo {
+	if b.Chest {
+		return item.FuelInfo{}
+	}
+	return newFuelInfo(time.Minute)
+}
+
+// EncodeItem ...
+func (b Boat) EncodeItem() (name string, meta int16) {
+	if b.Chest {
+		return "minecraft:" + b.Wood.String() + "_chest_boat", 0
+	}
+	return "minecraft:" + b.Wood.String() + "_boat", 0
+}
+
+// boatWoodTypes returns all wood types that boats exist for.
+func boatWoodTypes() []WoodType {
+	return []WoodType{OakWood(), SpruceWood(), BirchWood(), JungleWood(), AcaciaWood(), DarkOakWood(), Mangrove(), Cherry()}
+}
@@ -0,0 +1,142 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/recipe"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"sync"
+	"time"
+)
+
+// brewer is a struct that may be embedded by blocks that can brew potions, such as brewing stands.
+type brewer struct {
+	mu sync.Mutex
+
+	viewers   map[ContainerViewer]struct{}
+	inventory *inventory.Inventory
+
+	duration   time.Duration
+	fuelAmount int32
+	fuelTotal  int32
+}
+
+// newBrewer creates a new initialised brewer. The inventory of the brewer has one slot for the ingredient, three
+// slots for the potions to brew and one slot for the fuel.
+func newBrewer() *brewer {
+	b := &brewer{viewers: make(map[ContainerViewer]struct{})}
+	b.inventory = inventory.New(5, func(slot int, _, item item.Stack) {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for viewer := range b.viewers {
+			viewer.ViewSlotChange(slot, item)
+		}
+	})
+	return b
+}
+
+// Duration returns the remaining brewing duration of the brewer.
+func (b *brewer) Duration() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.duration
+}
+
+// Fuel returns the fuel amount and the fuel total of the brewer. The fuel amount is the amount of brews the brewer
+// can still perform before needing new fuel, while the total is the amount of brews it could perform when it was
+// last fuelled.
+func (b *brewer) Fuel() (fuelAmount, fuelTotal int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.fuelAmount, b.fuelTotal
+}
+
+// Inventory returns the inventory of the brewer.
+func (b *brewer) Inventory() *inventory.Inventory {
+	return b.inventory
+}
+
+// AddViewer adds a viewer to the brewer, so that it is updated whenever the inventory of the brewer is changed.
+func (b *brewer) AddViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the brewer, so that slot updates in the inventory are no longer sent to it.
+func (b *brewer) RemoveViewer(v ContainerViewer, _ *world.World, _ cube.Pos) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.viewers, v)
+}
+
+// setDuration sets the remaining brewing duration of the brewer.
+func (b *brewer) setDuration(duration time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.duration = duration
+}
+
+// setFuel sets the fuel amount and fuel total of the brewer.
+func (b *brewer) setFuel(fuelAmount, fuelTotal int32) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.fuelAmount, b.fuelTotal = fuelAmount, fuelTotal
+}
+
+// tickBrewing ticks the brewer, refuelling it if needed and brewing the potions in it using the recipes brewed on
+// the block passed. True is returned if a brew was finished during the tick.
+func (b *brewer) tickBrewing(block string, requirement time.Duration) (brewed bool) {
+	b.mu.Lock()
+
+	prevDuration, prevFuelAmount, prevFuelTotal := b.duration, b.fuelAmount, b.fuelTotal
+
+	// We don't need to validate errors here since we know the bounds of the brewer.
+	ingredient, _ := b.inventory.Item(0)
+	fuel, _ := b.inventory.Item(4)
+
+	// Blaze powder fuels the brewer for 20 brews at once, but only once the previous fuel has been used up.
+	if _, ok := fuel.Item().(item.BlazePowder); ok && b.fuelAmount <= 0 {
+		b.fuelAmount, b.fuelTotal = 20, 20
+		defer b.inventory.SetItem(4, fuel.Grow(-1))
+	}
+
+	var (
+		outputs  [3]item.Stack
+		brewable bool
+	)
+	for i := range outputs {
+		input, _ := b.inventory.Item(i + 1)
+		if output, ok := recipe.Brew(block, input, ingredient); ok {
+			outputs[i], brewable = output, true
+		}
+	}
+
+	switch {
+	case !brewable:
+		// Either the ingredient or all potions were removed, so we stop brewing.
+		b.duration = 0
+	case b.duration > 0:
+		if b.duration -= time.Millisecond * 50; b.duration <= 0 {
+			b.duration, brewed = 0, true
+			for i, output := range outputs {
+				if !output.Empty() {
+					defer b.inventory.SetItem(i+1, output)
+				}
+			}
+			defer b.inventory.SetItem(0, ingredient.Grow(-1))
+		}
+	case b.fuelAmount > 0:
+		// We can start a new brew, which uses up a single unit of fuel.
+		b.duration = requirement
+		b.fuelAmount--
+	}
+
+	for v := range b.viewers {
+		v.ViewBrewingUpdate(prevDuration, b.duration, prevFuelAmount, b.fuelAmount, prevFuelTotal, b.fuelTotal)
+	}
+
+	b.mu.Unlock()
+	return brewed
+}
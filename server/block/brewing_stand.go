@@ -0,0 +1,145 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// BrewingStand is a block used for brewing potions, splash potions and lingering potions. It is fuelled using
+// blaze powder.
+// The empty value of BrewingStand is not valid. It must be created using block.NewBrewingStand().
+type BrewingStand struct {
+	transparent
+	sourceWaterDisplacer
+	*brewer
+
+	// LeftSlot is true if the left potion slot of the brewing stand holds a potion.
+	LeftSlot bool
+	// MiddleSlot is true if the middle potion slot of the brewing stand holds a potion.
+	MiddleSlot bool
+	// RightSlot is true if the right potion slot of the brewing stand holds a potion.
+	RightSlot bool
+}
+
+// NewBrewingStand creates a new initialised brewing stand. The brewer is properly initialised.
+func NewBrewingStand() BrewingStand {
+	return BrewingStand{brewer: newBrewer()}
+}
+
+// Tick is called to brew the potions in the brewing stand and to update the potions displayed on it.
+func (b BrewingStand) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if b.brewer.tickBrewing("brewing_stand", time.Second*20) {
+		w.PlaySound(pos.Vec3Centre(), sound.PotionBrewed{})
+	}
+
+	left, _ := b.Inventory().Item(1)
+	middle, _ := b.Inventory().Item(2)
+	right, _ := b.Inventory().Item(3)
+	if b.LeftSlot != !left.Empty() || b.MiddleSlot != !middle.Empty() || b.RightSlot != !right.Empty() {
+		b.LeftSlot, b.MiddleSlot, b.RightSlot = !left.Empty(), !middle.Empty(), !right.Empty()
+		w.SetBlock(pos, b, nil)
+	}
+}
+
+// Model ...
+func (BrewingStand) Model() world.BlockModel {
+	return model.BrewingStand{}
+}
+
+// SideClosed ...
+func (BrewingStand) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (BrewingStand) LightEmissionLevel() uint8 {
+	return 1
+}
+
+// UseOnBlock ...
+func (b BrewingStand) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+
+	place(w, pos, NewBrewingStand(), user, ctx)
+	return placed(ctx)
+}
+
+// Activate ...
+func (BrewingStand) Activate(pos cube.Pos, _ cube.Face, _ *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+		return true
+	}
+	return false
+}
+
+// BreakInfo ...
+func (b BrewingStand) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, pickaxeEffective, oneOf(BrewingStand{}))
+}
+
+// EncodeItem ...
+func (BrewingStand) EncodeItem() (name string, meta int16) {
+	return "minecraft:brewing_stand", 0
+}
+
+// EncodeBlock ...
+func (b BrewingStand) EncodeBlock() (string, map[string]any) {
+	return "minecraft:brewing_stand", map[string]any{
+		"brewing_stand_slot_a_bit": b.LeftSlot,
+		"brewing_stand_slot_b_bit": b.MiddleSlot,
+		"brewing_stand_slot_c_bit": b.RightSlot,
+	}
+}
+
+// EncodeNBT ...
+func (b BrewingStand) EncodeNBT() map[string]any {
+	if b.brewer == nil {
+		//noinspection GoAssignmentToReceiver
+		b = NewBrewingStand()
+	}
+	fuelAmount, fuelTotal := b.Fuel()
+	return map[string]any{
+		"CookTime":   int16(b.Duration().Milliseconds() / 50),
+		"FuelAmount": int16(fuelAmount),
+		"FuelTotal":  int16(fuelTotal),
+		"Items":      nbtconv.InvToNBT(b.Inventory()),
+		"id":         "BrewingStand",
+	}
+}
+
+// DecodeNBT ...
+func (b BrewingStand) DecodeNBT(data map[string]any) any {
+	duration := nbtconv.TickDuration[int16](data, "CookTime")
+	fuelAmount, fuelTotal := int32(nbtconv.Int16(data, "FuelAmount")), int32(nbtconv.Int16(data, "FuelTotal"))
+
+	left, middle, right := b.LeftSlot, b.MiddleSlot, b.RightSlot
+	//noinspection GoAssignmentToReceiver
+	b = NewBrewingStand()
+	b.LeftSlot, b.MiddleSlot, b.RightSlot = left, middle, right
+	b.setDuration(duration)
+	b.setFuel(fuelAmount, fuelTotal)
+	nbtconv.InvFromNBT(b.Inventory(), nbtconv.Slice(data, "Items"))
+	return b
+}
+
+// allBrewingStands ...
+func allBrewingStands() (stands []world.Block) {
+	for _, left := range []bool{false, true} {
+		for _, middle := range []bool{false, true} {
+			for _, right := range []bool{false, true} {
+				stands = append(stands, BrewingStand{LeftSlot: left, MiddleSlot: middle, RightSlot: right})
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,57 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"math/rand"
+)
+
+// BuddingAmethyst is a block found in amethyst geodes that grows amethyst buds on its faces over time. Budding
+// amethyst cannot be obtained, not even with silk touch.
+type BuddingAmethyst struct {
+	solid
+}
+
+// RandomTick ...
+func (b BuddingAmethyst) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if r.Intn(5) != 0 {
+		return
+	}
+	face := cube.Faces()[r.Intn(len(cube.Faces()))]
+	target := pos.Side(face)
+	switch existing := w.Block(target).(type) {
+	case Air:
+		w.SetBlock(target, AmethystCluster{Facing: face}, nil)
+	case Water:
+		if existing.Depth == 8 && !existing.Falling {
+			w.SetBlock(target, AmethystCluster{Facing: face}, nil)
+			w.SetLiquid(target, existing)
+		}
+	case AmethystCluster:
+		if existing.Facing == face && existing.Stage < maxAmethystStage {
+			existing.Stage++
+			w.SetBlock(target, existing, nil)
+		}
+	}
+}
+
+// EntityStepOn ...
+func (BuddingAmethyst) EntityStepOn(pos cube.Pos, w *world.World, _ world.Entity) {
+	w.PlaySound(pos.Vec3Centre(), sound.AmethystChime{})
+}
+
+// BreakInfo ...
+func (b BuddingAmethyst) BreakInfo() BreakInfo {
+	return newBreakInfo(1.5, pickaxeHarvestable, pickaxeEffective, simpleDrops())
+}
+
+// EncodeItem ...
+func (BuddingAmethyst) EncodeItem() (name string, meta int16) {
+	return "minecraft:budding_amethyst", 0
+}
+
+// EncodeBlock ...
+func (BuddingAmethyst) EncodeBlock() (string, map[string]any) {
+	return "minecraft:budding_amethyst", nil
+}
@@ -0,0 +1,255 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// Campfire is a block that may be used to cook food, pacify bees and act as a light source. Up to four food items
+// may be placed on a lit campfire to be cooked.
+type Campfire struct {
+	bass
+	sourceWaterDisplacer
+
+	// Items holds the items being cooked on the campfire. A campfire holds up to four items, each in its own
+	// slot. Slots that are free hold an empty CampfireItem.
+	Items [4]CampfireItem
+	// Facing represents the direction that the campfire is facing.
+	Facing cube.Direction
+	// Extinguished specifies if the campfire is extinguished. Extinguished campfires do not cook items, emit light
+	// or damage entities standing on them.
+	Extinguished bool
+	// Type represents the type of campfire, which is either a normal campfire or a soul campfire.
+	Type FireType
+}
+
+// CampfireItem holds an item being cooked on a campfire, along with the time left until it is cooked.
+type CampfireItem struct {
+	// Item is the item being cooked.
+	Item item.Stack
+	// Time is the time left until the item is cooked.
+	Time time.Duration
+}
+
+// campfireCookTime is the time it takes for an item to cook on a campfire.
+const campfireCookTime = time.Second * 30
+
+// Model ...
+func (Campfire) Model() world.BlockModel {
+	return model.Campfire{}
+}
+
+// SideClosed ...
+func (Campfire) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (c Campfire) LightEmissionLevel() uint8 {
+	if c.Extinguished {
+		return 0
+	}
+	return c.Type.LightLevel()
+}
+
+// BreakInfo ...
+func (c Campfire) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, axeEffective, func(_ item.Tool, enchantments []item.Enchantment) []item.Stack {
+		var drops []item.Stack
+		if hasSilkTouch(enchantments) {
+			drops = append(drops, item.NewStack(Campfire{Type: c.Type}, 1))
+		} else if c.Type == SoulFire() {
+			drops = append(drops, item.NewStack(SoulSoil{}, 1))
+		} else {
+			drops = append(drops, item.NewStack(item.Charcoal{}, 2))
+		}
+		for _, it := range c.Items {
+			if !it.Item.Empty() {
+				drops = append(drops, it.Item)
+			}
+		}
+		return drops
+	})
+}
+
+// UseOnBlock ...
+func (c Campfire) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, c)
+	if !used {
+		return false
+	}
+	c = Campfire{Type: c.Type, Facing: user.Rotation().Direction().Opposite()}
+	if _, ok := w.Liquid(pos); ok {
+		c.Extinguished = true
+	}
+
+	place(w, pos, c, user, ctx)
+	return placed(ctx)
+}
+
+// Activate ...
+func (c Campfire) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if held.Empty() {
+		return false
+	}
+	if _, ok := held.Item().(item.FlintAndSteel); ok {
+		// Lighting the campfire is handled by the flint and steel itself.
+		return false
+	}
+	food, ok := held.Item().(item.Smeltable)
+	if !ok || !food.SmeltInfo().Food {
+		return false
+	}
+	for i, it := range c.Items {
+		if !it.Item.Empty() {
+			continue
+		}
+		c.Items[i] = CampfireItem{Item: held.Grow(-held.Count() + 1), Time: campfireCookTime}
+		w.SetBlock(pos, c, nil)
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	return false
+}
+
+// Ignite ...
+func (c Campfire) Ignite(pos cube.Pos, w *world.World, _ world.Entity) bool {
+	if !c.Extinguished {
+		return false
+	}
+	if _, ok := w.Liquid(pos); ok {
+		return false
+	}
+	c.Extinguished = false
+	w.SetBlock(pos, c, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.Ignite{})
+	return true
+}
+
+// Extinguish extinguishes the campfire at the position passed, dropping all items that were being cooked on it.
+// False is returned if the campfire was already extinguished.
+func (c Campfire) Extinguish(pos cube.Pos, w *world.World) bool {
+	if c.Extinguished {
+		return false
+	}
+	for _, it := range c.Items {
+		if !it.Item.Empty() {
+			dropItem(w, it.Item, pos.Vec3Middle())
+		}
+	}
+	c.Items, c.Extinguished = [4]CampfireItem{}, true
+	w.SetBlock(pos, c, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.FireExtinguish{})
+	return true
+}
+
+// NeighbourUpdateTick ...
+func (c Campfire) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if liquid, ok := w.Liquid(pos); ok {
+		if _, water := liquid.(Water); water {
+			c.Extinguish(pos, w)
+		}
+	}
+}
+
+// EntityInside ...
+func (c Campfire) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if c.Extinguished {
+		return
+	}
+	if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+		l.Hurt(c.Type.Damage(), FireDamageSource{})
+	}
+}
+
+// Tick cooks the items on the campfire if it is lit.
+func (c Campfire) Tick(_ int64, pos cube.Pos, w *world.World) {
+	if c.Extinguished {
+		return
+	}
+	if rand.Float64() <= 0.016 {
+		w.PlaySound(pos.Vec3Centre(), sound.CampfireCrackle{})
+	}
+
+	updated := false
+	for i, it := range c.Items {
+		if it.Item.Empty() {
+			continue
+		}
+		updated = true
+		if it.Time -= time.Millisecond * 50; it.Time > 0 {
+			c.Items[i] = it
+			continue
+		}
+		if food, ok := it.Item.Item().(item.Smeltable); ok {
+			dropItem(w, food.SmeltInfo().Product, pos.Vec3Middle())
+		}
+		c.Items[i] = CampfireItem{}
+	}
+	if updated {
+		w.SetBlock(pos, c, nil)
+	}
+}
+
+// EncodeItem ...
+func (c Campfire) EncodeItem() (name string, meta int16) {
+	if c.Type == SoulFire() {
+		return "minecraft:soul_campfire", 0
+	}
+	return "minecraft:campfire", 0
+}
+
+// EncodeBlock ...
+func (c Campfire) EncodeBlock() (string, map[string]any) {
+	name := "minecraft:campfire"
+	if c.Type == SoulFire() {
+		name = "minecraft:soul_campfire"
+	}
+	return name, map[string]any{"minecraft:cardinal_direction": c.Facing.String(), "extinguished": boolByte(c.Extinguished)}
+}
+
+// EncodeNBT ...
+func (c Campfire) EncodeNBT() map[string]any {
+	m := map[string]any{"id": "Campfire"}
+	for i, it := range c.Items {
+		if it.Item.Empty() {
+			continue
+		}
+		n := strconv.Itoa(i + 1)
+		m["Item"+n] = nbtconv.WriteItem(it.Item, true)
+		// The time is stored as the number of ticks that the item has been cooking for.
+		m["ItemTime"+n] = int32((campfireCookTime - it.Time) / (time.Millisecond * 50))
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (c Campfire) DecodeNBT(data map[string]any) any {
+	for i := range c.Items {
+		n := strconv.Itoa(i + 1)
+		c.Items[i] = CampfireItem{
+			Item: nbtconv.MapItem(data, "Item"+n),
+			Time: campfireCookTime - time.Duration(nbtconv.Int32(data, "ItemTime"+n))*time.Millisecond*50,
+		}
+	}
+	return c
+}
+
+// allCampfires ...
+func allCampfires() (campfires []world.Block) {
+	for _, d := range cube.Directions() {
+		for _, t := range FireTypes() {
+			campfires = append(campfires, Campfire{Facing: d, Type: t}, Campfire{Facing: d, Type: t, Extinguished: true})
+		}
+	}
+	return
+}
@@ -0,0 +1,405 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/potion"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"image/color"
+	"math/rand"
+	"time"
+)
+
+// Cauldron is a block that can hold water, lava, powder snow or potions. Cauldrons filled with water may be used to
+// wash the dye off leather armour and banners, or to dye leather armour once dye is added to the water.
+type Cauldron struct {
+	transparent
+
+	// Fluid is the type of fluid held by the cauldron.
+	Fluid CauldronFluid
+	// Level is the level of the fluid in the cauldron. It is a number from 0-6, where 0 is an empty cauldron and 6
+	// is a full cauldron.
+	Level int
+	// Potion is the potion held by the cauldron. It is nil if the cauldron does not hold a potion, and is otherwise
+	// either an item.Potion, item.SplashPotion or item.LingeringPotion. Cauldrons holding potions always have
+	// CauldronWater as their Fluid.
+	Potion world.Item
+	// CustomColour is the colour of the water in the cauldron after dye has been added to it. It is the zero value
+	// if the water has not been dyed.
+	CustomColour color.RGBA
+}
+
+// Model ...
+func (Cauldron) Model() world.BlockModel {
+	return model.Cauldron{}
+}
+
+// SideClosed ...
+func (Cauldron) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (c Cauldron) LightEmissionLevel() uint8 {
+	if c.Fluid == CauldronLava() && c.Level > 0 {
+		return 15
+	}
+	return 0
+}
+
+// BreakInfo ...
+func (c Cauldron) BreakInfo() BreakInfo {
+	return newBreakInfo(2, pickaxeHarvestable, pickaxeEffective, oneOf(Cauldron{})).withBlastResistance(2)
+}
+
+// EntityInside ...
+func (c Cauldron) EntityInside(pos cube.Pos, _ *world.World, e world.Entity) {
+	if c.Level == 0 || e.Position()[1] > float64(pos[1])+(6+float64(c.Level)*1.5)/16 {
+		return
+	}
+	if fallEntity, ok := e.(fallDistanceEntity); ok {
+		fallEntity.ResetFallDistance()
+	}
+	flammable, ok := e.(flammableEntity)
+	if !ok {
+		return
+	}
+	switch c.Fluid {
+	case CauldronWater():
+		flammable.Extinguish()
+	case CauldronLava():
+		if l, ok := e.(livingEntity); ok && !l.AttackImmune() {
+			l.Hurt(4, LavaDamageSource{})
+		}
+		flammable.SetOnFire(15 * time.Second)
+	}
+}
+
+// RandomTick fills the cauldron with water if it is raining above it, or with powder snow if it is snowing above
+// it.
+func (c Cauldron) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	above := pos.Side(cube.FaceUp)
+	switch {
+	case w.RainingAt(above) && c.holdsOnly(CauldronWater()):
+		c.Fluid = CauldronWater()
+	case w.SnowingAt(above) && c.holdsOnly(CauldronPowderSnow()):
+		c.Fluid = CauldronPowderSnow()
+	default:
+		return
+	}
+	if c.Level < 6 {
+		c.Level++
+		w.SetBlock(pos, c, nil)
+	}
+}
+
+// Activate ...
+func (c Cauldron) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if held.Empty() {
+		return false
+	}
+	switch it := held.Item().(type) {
+	case item.Bucket:
+		return c.useBucket(pos, w, it, ctx)
+	case item.GlassBottle:
+		return c.fillBottle(pos, w, ctx)
+	case item.Potion, item.SplashPotion, item.LingeringPotion:
+		return c.addPotion(pos, w, it, ctx)
+	case item.Dye:
+		if c.Fluid != CauldronWater() || c.Level == 0 || c.Potion != nil {
+			return false
+		}
+		if c.CustomColour == (color.RGBA{}) {
+			c.CustomColour = it.Colour.RGBA()
+		} else {
+			c.CustomColour = mixColours(c.CustomColour, it.Colour.RGBA())
+		}
+		w.SetBlock(pos, c, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronAddDye{})
+		ctx.SubtractFromCount(1)
+		return true
+	case Banner:
+		if c.Fluid != CauldronWater() || c.Level == 0 || c.Potion != nil || len(it.Patterns) == 0 || it.Illager {
+			return false
+		}
+		it.Patterns = it.Patterns[:len(it.Patterns)-1]
+		c.useLevels(pos, w, 1)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronCleanArmour{})
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = duplicateStack(held.Grow(1-held.Count()), it)
+		return true
+	}
+	colour, ok := leatherArmourColour(held.Item())
+	if !ok || c.Fluid != CauldronWater() || c.Level == 0 || c.Potion != nil {
+		return false
+	}
+	switch {
+	case c.CustomColour != (color.RGBA{}):
+		ctx.NewItem = duplicateStack(held, withLeatherArmourColour(held.Item(), c.CustomColour))
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronDyeArmour{})
+	case colour != (color.RGBA{}):
+		ctx.NewItem = duplicateStack(held, withLeatherArmourColour(held.Item(), color.RGBA{}))
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronCleanArmour{})
+	default:
+		return false
+	}
+	c.useLevels(pos, w, 1)
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// useBucket fills the cauldron using a bucket with water or lava, or fills an empty bucket using a full cauldron.
+func (c Cauldron) useBucket(pos cube.Pos, w *world.World, b item.Bucket, ctx *item.UseContext) bool {
+	if b.Empty() {
+		if c.Level < 6 || c.Potion != nil || c.CustomColour != (color.RGBA{}) {
+			return false
+		}
+		var liquid world.Liquid
+		switch c.Fluid {
+		case CauldronWater():
+			liquid = Water{Depth: 8, Still: true}
+		case CauldronLava():
+			liquid = Lava{Depth: 8, Still: true}
+		default:
+			// There is no bucket that can hold powder snow yet.
+			return false
+		}
+		w.SetBlock(pos, Cauldron{}, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.BucketFill{Liquid: liquid})
+		ctx.NewItem = item.NewStack(item.Bucket{Content: item.LiquidBucketContent(liquid)}, 1)
+		ctx.NewItemSurvivalOnly = true
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	liquid, ok := b.Content.Liquid()
+	if !ok {
+		return false
+	}
+	fluid := CauldronWater()
+	if _, ok := liquid.(Lava); ok {
+		fluid = CauldronLava()
+	} else if _, ok := liquid.(Water); !ok {
+		return false
+	}
+	if c.Fluid == fluid && c.Level == 6 && c.Potion == nil && c.CustomColour == (color.RGBA{}) {
+		return false
+	}
+	w.SetBlock(pos, Cauldron{Fluid: fluid, Level: 6}, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.BucketEmpty{Liquid: liquid})
+	ctx.NewItem = item.NewStack(item.Bucket{}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// fillBottle fills a glass bottle with the water or potion held by the cauldron.
+func (c Cauldron) fillBottle(pos cube.Pos, w *world.World, ctx *item.UseContext) bool {
+	if c.Fluid != CauldronWater() || c.Level < 2 {
+		return false
+	}
+	if c.Potion != nil {
+		ctx.NewItem = item.NewStack(c.Potion, 1)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronTakePotion{})
+	} else {
+		ctx.NewItem = item.NewStack(item.Potion{Type: potion.Water()}, 1)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronTakeWater{})
+	}
+	c.useLevels(pos, w, 2)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// addPotion adds the potion passed to the cauldron. If the cauldron already holds a different potion, the cauldron
+// is emptied.
+func (c Cauldron) addPotion(pos cube.Pos, w *world.World, it world.Item, ctx *item.UseContext) bool {
+	if !c.holdsOnly(CauldronWater()) || c.Level >= 6 {
+		return false
+	}
+	ctx.NewItem = item.NewStack(item.GlassBottle{}, 1)
+	ctx.NewItemSurvivalOnly = true
+	ctx.SubtractFromCount(1)
+
+	if p, ok := it.(item.Potion); ok && p.Type == potion.Water() {
+		if c.Potion != nil {
+			w.SetBlock(pos, Cauldron{}, nil)
+			w.PlaySound(pos.Vec3Centre(), sound.CauldronExplode{})
+			return true
+		}
+		c.Level = min(c.Level+2, 6)
+		w.SetBlock(pos, c, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronFillWater{})
+		return true
+	}
+	if c.Level > 0 && c.Potion != it {
+		w.SetBlock(pos, Cauldron{}, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.CauldronExplode{})
+		return true
+	}
+	c.Potion, c.Level = it, min(c.Level+2, 6)
+	w.SetBlock(pos, c, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.CauldronFillPotion{})
+	return true
+}
+
+// fillFromDrip fills the cauldron with a drop of water or lava dripping from dripstone above it. True is returned if
+// the cauldron was filled.
+func (c Cauldron) fillFromDrip(pos cube.Pos, w *world.World, lava bool) bool {
+	switch {
+	case lava && c.Level == 0:
+		c.Fluid, c.Level = CauldronLava(), 6
+	case !lava && c.holdsOnly(CauldronWater()) && c.Potion == nil && c.Level < 6:
+		c.Fluid, c.Level = CauldronWater(), min(c.Level+2, 6)
+	default:
+		return false
+	}
+	w.SetBlock(pos, c, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.CauldronDrip{Lava: lava})
+	return true
+}
+
+// holdsOnly checks if the cauldron is either empty or only holds the fluid passed.
+func (c Cauldron) holdsOnly(fluid CauldronFluid) bool {
+	return c.Level == 0 || c.Fluid == fluid
+}
+
+// useLevels lowers the level of the fluid in the cauldron by n, emptying the cauldron if no fluid is left.
+func (c Cauldron) useLevels(pos cube.Pos, w *world.World, n int) {
+	if c.Level -= n; c.Level <= 0 {
+		c = Cauldron{}
+	}
+	w.SetBlock(pos, c, nil)
+}
+
+// EncodeItem ...
+func (Cauldron) EncodeItem() (name string, meta int16) {
+	return "minecraft:cauldron", 0
+}
+
+// EncodeBlock ...
+func (c Cauldron) EncodeBlock() (string, map[string]any) {
+	return "minecraft:cauldron", map[string]any{"cauldron_liquid": c.Fluid.String(), "fill_level": int32(c.Level)}
+}
+
+// EncodeNBT ...
+func (c Cauldron) EncodeNBT() map[string]any {
+	potionID, potionType := int16(-1), int16(-1)
+	switch p := c.Potion.(type) {
+	case item.Potion:
+		potionID, potionType = int16(p.Type.Uint8()), 0
+	case item.SplashPotion:
+		potionID, potionType = int16(p.Type.Uint8()), 1
+	case item.LingeringPotion:
+		potionID, potionType = int16(p.Type.Uint8()), 2
+	}
+	m := map[string]any{"id": "Cauldron", "PotionId": potionID, "PotionType": potionType}
+	if c.CustomColour != (color.RGBA{}) {
+		m["CustomColor"] = nbtconv.Int32FromRGBA(c.CustomColour)
+	}
+	return m
+}
+
+// DecodeNBT ...
+func (c Cauldron) DecodeNBT(data map[string]any) any {
+	c.Potion, c.CustomColour = nil, color.RGBA{}
+	if id := nbtconv.Int16(data, "PotionId"); id >= 0 {
+		t := potion.From(int32(id))
+		switch nbtconv.Int16(data, "PotionType") {
+		case 0:
+			c.Potion = item.Potion{Type: t}
+		case 1:
+			c.Potion = item.SplashPotion{Type: t}
+		case 2:
+			c.Potion = item.LingeringPotion{Type: t}
+		}
+	}
+	if _, ok := data["CustomColor"]; ok {
+		c.CustomColour = nbtconv.RGBAFromInt32(nbtconv.Int32(data, "CustomColor"))
+	}
+	return c
+}
+
+// mixColours mixes the colours passed using the rules of mixing dyes: The colours are averaged, after which the
+// brightness of the result is scaled to the average brightness of the colours mixed.
+func mixColours(colours ...color.RGBA) color.RGBA {
+	var r, g, b, brightness int
+	for _, c := range colours {
+		r, g, b = r+int(c.R), g+int(c.G), b+int(c.B)
+		brightness += max(int(c.R), max(int(c.G), int(c.B)))
+	}
+	n := len(colours)
+	r, g, b, brightness = r/n, g/n, b/n, brightness/n
+	if m := max(r, max(g, b)); m > 0 {
+		r, g, b = r*brightness/m, g*brightness/m, b*brightness/m
+	}
+	return color.RGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: 0xff}
+}
+
+// leatherArmourColour returns the colour of the leather armour item passed. False is returned if the item is not
+// leather armour.
+func leatherArmourColour(it world.Item) (color.RGBA, bool) {
+	var tier item.ArmourTier
+	switch a := it.(type) {
+	case item.Helmet:
+		tier = a.Tier
+	case item.Chestplate:
+		tier = a.Tier
+	case item.Leggings:
+		tier = a.Tier
+	case item.Boots:
+		tier = a.Tier
+	}
+	t, ok := tier.(item.ArmourTierLeather)
+	return t.Colour, ok
+}
+
+// withLeatherArmourColour returns the leather armour item passed with its colour changed to the colour passed.
+func withLeatherArmourColour(it world.Item, c color.RGBA) world.Item {
+	tier := item.ArmourTierLeather{Colour: c}
+	switch a := it.(type) {
+	case item.Helmet:
+		a.Tier = tier
+		return a
+	case item.Chestplate:
+		a.Tier = tier
+		return a
+	case item.Leggings:
+		a.Tier = tier
+		return a
+	case item.Boots:
+		a.Tier = tier
+		return a
+	}
+	return it
+}
+
+// duplicateStack duplicates an item.Stack with the new item type passed, keeping the data of the stack.
+func duplicateStack(input item.Stack, newType world.Item) item.Stack {
+	outputStack := item.NewStack(newType, input.Count()).
+		Damage(input.MaxDurability() - input.Durability()).
+		WithCustomName(input.CustomName()).
+		WithLore(input.Lore()...).
+		WithEnchantments(input.Enchantments()...).
+		WithAnvilCost(input.AnvilCost())
+	if trim, ok := input.ArmourTrim(); ok {
+		outputStack = outputStack.WithArmourTrim(trim)
+	}
+	for k, v := range input.Values() {
+		outputStack = outputStack.WithValue(k, v)
+	}
+	return outputStack
+}
+
+// allCauldrons ...
+func allCauldrons() (cauldrons []world.Block) {
+	for _, f := range CauldronFluids() {
+		for i := 0; i <= 6; i++ {
+			cauldrons = append(cauldrons, Cauldron{Fluid: f, Level: i})
+		}
+	}
+	return
+}
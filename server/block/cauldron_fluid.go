@@ -0,0 +1,46 @@
+package block
+
+// CauldronFluid represents a type of fluid that may be held by a cauldron.
+type CauldronFluid struct {
+	cauldronFluid
+}
+
+type cauldronFluid uint8
+
+// CauldronWater is the fluid of cauldrons filled with water. Cauldrons holding potions also use this fluid.
+func CauldronWater() CauldronFluid {
+	return CauldronFluid{0}
+}
+
+// CauldronLava is the fluid of cauldrons filled with lava.
+func CauldronLava() CauldronFluid {
+	return CauldronFluid{1}
+}
+
+// CauldronPowderSnow is the fluid of cauldrons filled with powder snow.
+func CauldronPowderSnow() CauldronFluid {
+	return CauldronFluid{2}
+}
+
+// Uint8 returns the cauldron fluid as a uint8.
+func (c cauldronFluid) Uint8() uint8 {
+	return uint8(c)
+}
+
+// String ...
+func (c cauldronFluid) String() string {
+	switch c {
+	case 0:
+		return "water"
+	case 1:
+		return "lava"
+	case 2:
+		return "powder_snow"
+	}
+	panic("unknown cauldron fluid")
+}
+
+// CauldronFluids returns all possible cauldron fluids.
+func CauldronFluids() []CauldronFluid {
+	return []CauldronFluid{CauldronWater(), CauldronLava(), CauldronPowderSnow()}
+}
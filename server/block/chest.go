@@ -74,6 +74,7 @@ func (c Chest) open(w *world.World, pos cube.Pos) {
 		v.ViewBlockAction(pos, OpenAction{})
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.ChestOpen{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerOpen(), nil)
 }
 
 // close closes the chest, displaying the animation and playing a sound.
@@ -82,6 +83,7 @@ func (c Chest) close(w *world.World, pos cube.Pos) {
 		v.ViewBlockAction(pos, CloseAction{})
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.ChestClose{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerClose(), nil)
 }
 
 // AddViewer adds a viewer to the chest, so that it is updated whenever the inventory of the chest is changed.
@@ -0,0 +1,217 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Copper is a solid block crafted from copper ingots. Copper slowly oxidises over time, unless it is waxed using a
+// honeycomb. Oxidation and wax may be scraped off using an axe.
+type Copper struct {
+	solid
+
+	// Type is the type of copper block.
+	Type CopperType
+	// Oxidation is the stage of oxidation of the copper block.
+	Oxidation OxidationType
+	// Waxed specifies if the copper block is waxed. Waxed copper no longer oxidises.
+	Waxed bool
+}
+
+// RandomTicks returns true if the copper block can still oxidise.
+func (c Copper) RandomTicks() bool {
+	return oxidisable(c)
+}
+
+// RandomTick ...
+func (c Copper) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	oxidise(pos, w, r, c)
+}
+
+// Wax ...
+func (c Copper) Wax(cube.Pos, mgl64.Vec3) (world.Block, bool) {
+	return waxCopper(c)
+}
+
+// Scrape ...
+func (c Copper) Scrape() (world.Block, bool, bool) {
+	return scrapeCopper(c)
+}
+
+// Deoxidise ...
+func (c Copper) Deoxidise() (world.Block, bool) {
+	return deoxidiseCopper(c)
+}
+
+// BreakInfo ...
+func (c Copper) BreakInfo() BreakInfo {
+	return newBreakInfo(3, copperHarvestable, pickaxeEffective, oneOf(c)).withBlastResistance(6)
+}
+
+// EncodeItem ...
+func (c Copper) EncodeItem() (name string, meta int16) {
+	name, _ = c.EncodeBlock()
+	return name, 0
+}
+
+// EncodeBlock ...
+func (c Copper) EncodeBlock() (string, map[string]any) {
+	if c.Type == NormalCopper() && c.Oxidation == UnoxidisedOxidation() && !c.Waxed {
+		return "minecraft:copper_block", nil
+	}
+	return "minecraft:" + c.prefix() + c.Type.String(), nil
+}
+
+// prefix returns the prefix of the name of the copper block, which holds the wax and oxidation of the block.
+func (c Copper) prefix() string {
+	var prefix string
+	if c.Waxed {
+		prefix = "waxed_"
+	}
+	if c.Oxidation != UnoxidisedOxidation() {
+		prefix += c.Oxidation.String() + "_"
+	}
+	return prefix
+}
+
+// copperHarvestable is the harvestable function of copper blocks, which require at least a stone pickaxe to be
+// harvested.
+var copperHarvestable = func(t item.Tool) bool {
+	return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierStone.HarvestLevel
+}
+
+// copperOf returns the Copper that the block passed is made of. Copper blocks, and stairs and slabs made of copper
+// are supported. If the block passed is not made of copper, false is returned.
+func copperOf(b world.Block) (Copper, bool) {
+	switch b := b.(type) {
+	case Copper:
+		return b, true
+	case Stairs:
+		c, ok := b.Block.(Copper)
+		return c, ok
+	case Slab:
+		c, ok := b.Block.(Copper)
+		return c, ok
+	}
+	return Copper{}, false
+}
+
+// withCopper returns the block passed with the Copper it is made of replaced with the Copper passed.
+func withCopper(b world.Block, c Copper) world.Block {
+	switch b := b.(type) {
+	case Stairs:
+		b.Block = c
+		return b
+	case Slab:
+		b.Block = c
+		return b
+	}
+	return c
+}
+
+// waxCopper waxes the copper block passed, preventing it from oxidising further. False is returned if the block is
+// not made of copper or is already waxed.
+func waxCopper(b world.Block) (world.Block, bool) {
+	c, ok := copperOf(b)
+	if !ok || c.Waxed {
+		return b, false
+	}
+	c.Waxed = true
+	return withCopper(b, c), true
+}
+
+// scrapeCopper scrapes the wax off the copper block passed or, if it is not waxed, lowers its oxidation by one
+// stage. The first bool returned is true if wax was scraped off. The second bool is false if the block could not be
+// scraped at all.
+func scrapeCopper(b world.Block) (world.Block, bool, bool) {
+	c, ok := copperOf(b)
+	if !ok {
+		return b, false, false
+	}
+	if c.Waxed {
+		c.Waxed = false
+		return withCopper(b, c), true, true
+	}
+	res, ok := deoxidiseCopper(b)
+	return res, false, ok
+}
+
+// deoxidiseCopper lowers the oxidation of the copper block passed by one stage. False is returned if the block is
+// waxed or not oxidised.
+func deoxidiseCopper(b world.Block) (world.Block, bool) {
+	c, ok := copperOf(b)
+	if !ok || c.Waxed {
+		return b, false
+	}
+	if c.Oxidation, ok = c.Oxidation.Decrease(); !ok {
+		return b, false
+	}
+	return withCopper(b, c), true
+}
+
+// oxidisable checks if the block passed is made of copper that can still oxidise.
+func oxidisable(b world.Block) bool {
+	c, ok := copperOf(b)
+	return ok && !c.Waxed && c.Oxidation != OxidisedOxidation()
+}
+
+// oxidise attempts to advance the oxidation of the copper block passed by one stage. Copper oxidises faster if
+// surrounded by copper blocks that are more oxidised, and does not oxidise at all if any copper block nearby is
+// less oxidised.
+func oxidise(pos cube.Pos, w *world.World, r *rand.Rand, b world.Block) {
+	if !oxidisable(b) || r.Float64() >= 64.0/1125.0 {
+		return
+	}
+	c, _ := copperOf(b)
+	level := c.Oxidation.Uint8()
+	var same, higher int
+	for x := -4; x <= 4; x++ {
+		for y := -4; y <= 4; y++ {
+			for z := -4; z <= 4; z++ {
+				if dist := abs(x) + abs(y) + abs(z); dist == 0 || dist > 4 {
+					continue
+				}
+				other, ok := copperOf(w.Block(pos.Add(cube.Pos{x, y, z})))
+				if !ok || other.Waxed {
+					continue
+				}
+				switch otherLevel := other.Oxidation.Uint8(); {
+				case otherLevel < level:
+					return
+				case otherLevel > level:
+					higher++
+				default:
+					same++
+				}
+			}
+		}
+	}
+	chance := float64(higher+1) / float64(higher+same+1)
+	chance *= chance
+	if c.Oxidation == UnoxidisedOxidation() {
+		chance *= 0.75
+	}
+	if r.Float64() < chance {
+		c.Oxidation, _ = c.Oxidation.Increase()
+		w.SetBlock(pos, withCopper(b, c), nil)
+	}
+}
+
+// copperBlocks returns all copper blocks of the copper type passed, in every stage of oxidation, waxed and unwaxed.
+func copperBlocks(t CopperType) (b []world.Block) {
+	for _, o := range OxidationTypes() {
+		b = append(b, Copper{Type: t, Oxidation: o}, Copper{Type: t, Oxidation: o, Waxed: true})
+	}
+	return
+}
+
+// allCopper ...
+func allCopper() (b []world.Block) {
+	for _, t := range CopperTypes() {
+		b = append(b, copperBlocks(t)...)
+	}
+	return
+}
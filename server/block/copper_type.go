@@ -0,0 +1,50 @@
+package block
+
+// CopperType represents a variant of copper blocks.
+type CopperType struct {
+	copper
+}
+
+// NormalCopper returns the normal copper variant, also known as the block of copper.
+func NormalCopper() CopperType {
+	return CopperType{0}
+}
+
+// CutCopper returns the cut copper variant.
+func CutCopper() CopperType {
+	return CopperType{1}
+}
+
+// CopperTypes returns a list of all copper types.
+func CopperTypes() []CopperType {
+	return []CopperType{NormalCopper(), CutCopper()}
+}
+
+type copper uint8
+
+// Uint8 returns the copper type as a uint8.
+func (c copper) Uint8() uint8 {
+	return uint8(c)
+}
+
+// Name ...
+func (c copper) Name() string {
+	switch c {
+	case 0:
+		return "Block of Copper"
+	case 1:
+		return "Cut Copper"
+	}
+	panic("unknown copper type")
+}
+
+// String ...
+func (c copper) String() string {
+	switch c {
+	case 0:
+		return "copper"
+	case 1:
+		return "cut_copper"
+	}
+	panic("unknown copper type")
+}
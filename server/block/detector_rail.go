@@ -0,0 +1,128 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// DetectorRail is a rail that emits a redstone signal while a minecart is on it.
+type DetectorRail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail. Detector rails cannot be curved.
+	Shape RailShape
+	// Powered is whether a minecart is on the rail, in which case it emits redstone power.
+	Powered bool
+}
+
+// RailShape ...
+func (r DetectorRail) RailShape() RailShape {
+	return r.Shape
+}
+
+// WithRailShape ...
+func (r DetectorRail) WithRailShape(s RailShape) Track {
+	r.Shape = s
+	return r
+}
+
+// EntityInside powers the rail if the entity is a Cart riding on it.
+func (r DetectorRail) EntityInside(pos cube.Pos, w *world.World, e world.Entity) {
+	if r.Powered || !detectsCart(pos, e) {
+		return
+	}
+	r.Powered = true
+	w.SetBlock(pos, r, nil)
+	w.ScheduleBlockUpdate(pos, time.Second)
+}
+
+// ScheduledTick ...
+func (r DetectorRail) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if !r.Powered {
+		return
+	}
+	carts := w.EntitiesWithin(cube.Box(0, 0, 0, 1, 1, 1).Translate(pos.Vec3()), func(e world.Entity) bool {
+		return !detectsCart(pos, e)
+	})
+	if len(carts) > 0 {
+		w.ScheduleBlockUpdate(pos, time.Second)
+		return
+	}
+	r.Powered = false
+	w.SetBlock(pos, r, nil)
+}
+
+// detectsCart checks if the entity passed is a Cart riding on the rail at the position passed.
+func detectsCart(pos cube.Pos, e world.Entity) bool {
+	c, ok := e.(Cart)
+	if !ok {
+		return false
+	}
+	rail, ok := c.Rail()
+	return ok && rail == pos
+}
+
+// WeakPower ...
+func (r DetectorRail) WeakPower(cube.Pos, cube.Face, *world.World) int {
+	if r.Powered {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (r DetectorRail) StrongPower(_ cube.Pos, face cube.Face, _ *world.World) int {
+	if r.Powered && face == cube.FaceDown {
+		return 15
+	}
+	return 0
+}
+
+// UseOnBlock ...
+func (r DetectorRail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	_, placed := placeTrack(pos, face, w, user, ctx, DetectorRail{Shape: r.Shape})
+	return placed
+}
+
+// NeighbourUpdateTick ...
+func (r DetectorRail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	breakUnsupportedTrack(pos, w, DetectorRail{})
+}
+
+// HasLiquidDrops ...
+func (DetectorRail) HasLiquidDrops() bool {
+	return true
+}
+
+// SideClosed ...
+func (DetectorRail) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (r DetectorRail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(DetectorRail{}))
+}
+
+// EncodeItem ...
+func (DetectorRail) EncodeItem() (name string, meta int16) {
+	return "minecraft:detector_rail", 0
+}
+
+// EncodeBlock ...
+func (r DetectorRail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:detector_rail", map[string]any{"rail_direction": int32(r.Shape.Uint8()), "rail_data_bit": r.Powered}
+}
+
+// allDetectorRails ...
+func allDetectorRails() (rails []world.Block) {
+	for _, s := range StraightRailShapes() {
+		rails = append(rails, DetectorRail{Shape: s}, DetectorRail{Shape: s, Powered: true})
+	}
+	return
+}
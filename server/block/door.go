@@ -0,0 +1,48 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+)
+
+// door is a block that consists of a top and bottom half that are opened and closed together, such as a WoodDoor
+// or an IronDoor.
+type door interface {
+	world.Block
+	// doorState returns whether the door is the top half of the door and whether it is open.
+	doorState() (top, open bool)
+	// withOpen returns the door with its open state changed to the value passed.
+	withOpen(open bool) world.Block
+}
+
+// updateDoorPower opens the door at the position passed if either of its halves receives redstone power, or
+// closes it if neither of them does.
+func updateDoorPower[D door](d D, pos cube.Pos, w *world.World) {
+	top, open := d.doorState()
+	otherPos := pos.Side(cube.Face(boolByte(!top)))
+	if powered := w.RedstonePower(pos) > 0 || w.RedstonePower(otherPos) > 0; powered != open {
+		setDoorOpen(d, pos, w, powered)
+	}
+}
+
+// setDoorOpen opens or closes both halves of the door at the position passed. A sound is played if the door was
+// opened or closed.
+func setDoorOpen[D door](d D, pos cube.Pos, w *world.World, open bool) {
+	top, wasOpen := d.doorState()
+	b := d.withOpen(open)
+	w.SetBlock(pos, b, nil)
+
+	otherPos := pos.Side(cube.Face(boolByte(!top)))
+	if other, ok := w.Block(otherPos).(D); ok {
+		w.SetBlock(otherPos, other.withOpen(open), nil)
+	}
+	if open == wasOpen {
+		return
+	}
+	if open {
+		w.PlaySound(pos.Vec3Centre(), sound.DoorOpen{Block: b})
+		return
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.DoorClose{Block: b})
+}
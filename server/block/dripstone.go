@@ -1,6 +1,13 @@
 package block
 
-// Dripstone is a rock block that allows pointed dripstone to grow beneath it.
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"math/rand"
+)
+
+// Dripstone is a rock block that allows pointed dripstone to grow beneath it. Dripstone with a source of water or
+// lava above it drips the liquid into cauldrons below it.
 type Dripstone struct {
 	solid
 	bassDrum
@@ -11,6 +18,32 @@ func (d Dripstone) BreakInfo() BreakInfo {
 	return newBreakInfo(1.5, pickaxeHarvestable, pickaxeEffective, oneOf(d)).withBlastResistance(5)
 }
 
+// RandomTick ...
+func (d Dripstone) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	liquid, ok := w.Liquid(pos.Side(cube.FaceUp))
+	if !ok || liquid.LiquidDepth() != 8 || liquid.LiquidFalling() {
+		return
+	}
+	_, lava := liquid.(Lava)
+	if lava && r.Float64() > 0.05859375 || !lava && r.Float64() > 0.17578125 {
+		return
+	}
+	// Drops fall up to 11 blocks down until they hit a block that is not air.
+	for y := 1; y <= 11; y++ {
+		below := pos.Sub(cube.Pos{0, y, 0})
+		if below.OutOfBounds(w.Range()) {
+			return
+		}
+		switch b := w.Block(below).(type) {
+		case Air:
+			continue
+		case Cauldron:
+			b.fillFromDrip(below, w, lava)
+		}
+		return
+	}
+}
+
 // EncodeItem ...
 func (d Dripstone) EncodeItem() (name string, meta int16) {
 	return "minecraft:dripstone_block", 0
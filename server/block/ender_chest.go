@@ -96,6 +96,7 @@ func (c EnderChest) open(w *world.World, pos cube.Pos) {
 		v.ViewBlockAction(pos, OpenAction{})
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.EnderChestOpen{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerOpen(), nil)
 }
 
 // close closes the ender chest, displaying the animation and playing a sound.
@@ -104,6 +105,7 @@ func (c EnderChest) close(w *world.World, pos cube.Pos) {
 		v.ViewBlockAction(pos, CloseAction{})
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.EnderChestClose{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerClose(), nil)
 }
 
 // EncodeNBT ...
@@ -26,6 +26,12 @@ type ExplosionConfig struct {
 	// DisableItemDrops, when set to true, will prevent any item entities from dropping as a result of blocks being
 	// destroyed.
 	DisableItemDrops bool
+	// DisableBlockDamage, when set to true, will prevent the explosion from destroying any blocks. Explodable
+	// blocks, such as TNT, are not affected by the explosion either.
+	DisableBlockDamage bool
+	// DisableEntityDamage, when set to true, will prevent the explosion from hurting any entities caught in it.
+	// These entities are still knocked back by the explosion.
+	DisableEntityDamage bool
 
 	// Sound is the sound to play when the explosion is created. If set to nil, this will default to the sound of a
 	// regular explosion.
@@ -95,7 +101,7 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 		if !e.Type().BBox(e).Translate(pos).IntersectsWith(box) {
 			continue
 		}
-		dist := pos.Sub(pos).Len()
+		dist := pos.Sub(explosionPos).Len()
 		if dist >= d {
 			continue
 		}
@@ -105,6 +111,18 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 		}
 	}
 
+	if !c.DisableBlockDamage {
+		c.destroyBlocks(w, explosionPos, r)
+	}
+
+	w.AddParticle(explosionPos, c.Particle)
+	w.PlaySound(explosionPos, c.Sound)
+	w.EmitGameEvent(explosionPos, world.GameEventExplode(), nil)
+}
+
+// destroyBlocks destroys all blocks that are reached by the rays of an explosion at the position passed, and
+// spawns fire if the ExplosionConfig requires it.
+func (c ExplosionConfig) destroyBlocks(w *world.World, explosionPos mgl64.Vec3, r *rand.Rand) {
 	affectedBlocks := make([]cube.Pos, 0, 32)
 	for _, ray := range rays {
 		pos := explosionPos
@@ -150,9 +168,6 @@ func (c ExplosionConfig) Explode(w *world.World, explosionPos mgl64.Vec3) {
 			}
 		}
 	}
-
-	w.AddParticle(explosionPos, c.Particle)
-	w.PlaySound(explosionPos, c.Sound)
 }
 
 // exposure returns the exposure of an explosion to an entity, used to calculate the impact of an explosion.
@@ -3,8 +3,10 @@
 package block
 
 const (
-	hashAir = iota
+	hashActivatorRail = iota
+	hashAir
 	hashAmethyst
+	hashAmethystCluster
 	hashAncientDebris
 	hashAndesite
 	hashAnvil
@@ -13,19 +15,25 @@ const (
 	hashBarrier
 	hashBasalt
 	hashBeacon
+	hashBed
 	hashBedrock
+	hashBeehive
 	hashBeetrootSeeds
 	hashBlackstone
 	hashBlastFurnace
 	hashBlueIce
 	hashBone
 	hashBookshelf
+	hashBrewingStand
 	hashBricks
+	hashBuddingAmethyst
 	hashCactus
 	hashCake
 	hashCalcite
+	hashCampfire
 	hashCarpet
 	hashCarrot
+	hashCauldron
 	hashChain
 	hashChest
 	hashChiseledQuartz
@@ -37,6 +45,7 @@ const (
 	hashComposter
 	hashConcrete
 	hashConcretePowder
+	hashCopper
 	hashCopperOre
 	hashCoral
 	hashCoralBlock
@@ -46,6 +55,7 @@ const (
 	hashDeepslate
 	hashDeepslateBricks
 	hashDeepslateTiles
+	hashDetectorRail
 	hashDiamond
 	hashDiamondOre
 	hashDiorite
@@ -56,7 +66,6 @@ const (
 	hashDragonEgg
 	hashDriedKelp
 	hashDripstone
-	hashDropper
 	hashEmerald
 	hashEmeraldOre
 	hashEnchantingTable
@@ -81,10 +90,13 @@ const (
 	hashGrindstone
 	hashHayBale
 	hashHoneycomb
+	hashIce
 	hashInvisibleBedrock
 	hashIron
 	hashIronBars
+	hashIronDoor
 	hashIronOre
+	hashIronTrapdoor
 	hashItemFrame
 	hashJukebox
 	hashKelp
@@ -95,10 +107,12 @@ const (
 	hashLava
 	hashLeaves
 	hashLectern
+	hashLever
 	hashLight
 	hashLitPumpkin
 	hashLog
 	hashLoom
+	hashMangrovePropagule
 	hashMelon
 	hashMelonSeeds
 	hashMossCarpet
@@ -122,6 +136,7 @@ const (
 	hashPodzol
 	hashPolishedBlackstoneBrick
 	hashPotato
+	hashPoweredRail
 	hashPrismarine
 	hashPumpkin
 	hashPumpkinSeeds
@@ -130,21 +145,32 @@ const (
 	hashQuartz
 	hashQuartzBricks
 	hashQuartzPillar
+	hashRail
 	hashRawCopper
 	hashRawGold
 	hashRawIron
+	hashRedstoneBlock
 	hashReinforcedDeepslate
+	hashRespawnAnchor
 	hashSand
 	hashSandstone
+	hashSapling
+	hashScaffolding
+	hashSculk
+	hashSculkCatalyst
+	hashSculkSensor
+	hashSculkShrieker
 	hashSeaLantern
 	hashSeaPickle
 	hashShroomlight
+	hashShulkerBox
 	hashSign
 	hashSkull
 	hashSlab
 	hashSmithingTable
 	hashSmoker
 	hashSnow
+	hashSnowLayer
 	hashSoulSand
 	hashSoulSoil
 	hashSponge
@@ -183,6 +209,11 @@ func NextHash() uint64 {
 	return customBlockBase
 }
 
+// Hash ...
+func (r ActivatorRail) Hash() uint64 {
+	return hashActivatorRail | uint64(r.Shape.Uint8())<<8 | uint64(boolByte(r.Powered))<<12
+}
+
 // Hash ...
 func (Air) Hash() uint64 {
 	return hashAir
@@ -193,6 +224,11 @@ func (Amethyst) Hash() uint64 {
 	return hashAmethyst
 }
 
+// Hash ...
+func (a AmethystCluster) Hash() uint64 {
+	return hashAmethystCluster | uint64(a.Stage)<<8 | uint64(a.Facing)<<16
+}
+
 // Hash ...
 func (AncientDebris) Hash() uint64 {
 	return hashAncientDebris
@@ -233,11 +269,21 @@ func (Beacon) Hash() uint64 {
 	return hashBeacon
 }
 
+// Hash ...
+func (b Bed) Hash() uint64 {
+	return hashBed | uint64(b.Facing)<<8 | uint64(boolByte(b.Head))<<10 | uint64(boolByte(b.Occupied))<<11
+}
+
 // Hash ...
 func (b Bedrock) Hash() uint64 {
 	return hashBedrock | uint64(boolByte(b.InfiniteBurning))<<8
 }
 
+// Hash ...
+func (b Beehive) Hash() uint64 {
+	return hashBeehive | uint64(boolByte(b.Nest))<<8 | uint64(b.Facing)<<9 | uint64(b.HoneyLevel)<<11
+}
+
 // Hash ...
 func (b BeetrootSeeds) Hash() uint64 {
 	return hashBeetrootSeeds | uint64(b.Growth)<<8
@@ -250,7 +296,7 @@ func (b Blackstone) Hash() uint64 {
 
 // Hash ...
 func (b BlastFurnace) Hash() uint64 {
-	return hashBlastFurnace | uint64(b.Facing)<<8 | uint64(boolByte(b.Lit))<<11
+	return hashBlastFurnace | uint64(b.Facing)<<8 | uint64(boolByte(b.Lit))<<10
 }
 
 // Hash ...
@@ -268,11 +314,21 @@ func (Bookshelf) Hash() uint64 {
 	return hashBookshelf
 }
 
+// Hash ...
+func (b BrewingStand) Hash() uint64 {
+	return hashBrewingStand | uint64(boolByte(b.LeftSlot))<<8 | uint64(boolByte(b.MiddleSlot))<<9 | uint64(boolByte(b.RightSlot))<<10
+}
+
 // Hash ...
 func (Bricks) Hash() uint64 {
 	return hashBricks
 }
 
+// Hash ...
+func (BuddingAmethyst) Hash() uint64 {
+	return hashBuddingAmethyst
+}
+
 // Hash ...
 func (c Cactus) Hash() uint64 {
 	return hashCactus | uint64(c.Age)<<8
@@ -288,6 +344,11 @@ func (Calcite) Hash() uint64 {
 	return hashCalcite
 }
 
+// Hash ...
+func (c Campfire) Hash() uint64 {
+	return hashCampfire | uint64(c.Facing)<<8 | uint64(boolByte(c.Extinguished))<<10 | uint64(c.Type.Uint8())<<11
+}
+
 // Hash ...
 func (c Carpet) Hash() uint64 {
 	return hashCarpet | uint64(c.Colour.Uint8())<<8
@@ -298,6 +359,11 @@ func (c Carrot) Hash() uint64 {
 	return hashCarrot | uint64(c.Growth)<<8
 }
 
+// Hash ...
+func (c Cauldron) Hash() uint64 {
+	return hashCauldron | uint64(c.Fluid.Uint8())<<8 | uint64(c.Level)<<10
+}
+
 // Hash ...
 func (c Chain) Hash() uint64 {
 	return hashChain | uint64(c.Axis)<<8
@@ -353,6 +419,11 @@ func (c ConcretePowder) Hash() uint64 {
 	return hashConcretePowder | uint64(c.Colour.Uint8())<<8
 }
 
+// Hash ...
+func (c Copper) Hash() uint64 {
+	return hashCopper | uint64(c.Type.Uint8())<<8 | uint64(c.Oxidation.Uint8())<<10 | uint64(boolByte(c.Waxed))<<12
+}
+
 // Hash ...
 func (c CopperOre) Hash() uint64 {
 	return hashCopperOre | uint64(c.Type.Uint8())<<8
@@ -398,6 +469,11 @@ func (d DeepslateTiles) Hash() uint64 {
 	return hashDeepslateTiles | uint64(boolByte(d.Cracked))<<8
 }
 
+// Hash ...
+func (r DetectorRail) Hash() uint64 {
+	return hashDetectorRail | uint64(r.Shape.Uint8())<<8 | uint64(boolByte(r.Powered))<<12
+}
+
 // Hash ...
 func (Diamond) Hash() uint64 {
 	return hashDiamond
@@ -505,7 +581,7 @@ func (f Froglight) Hash() uint64 {
 
 // Hash ...
 func (f Furnace) Hash() uint64 {
-	return hashFurnace | uint64(f.Facing)<<8 | uint64(boolByte(f.Lit))<<11
+	return hashFurnace | uint64(f.Facing)<<8 | uint64(boolByte(f.Lit))<<10
 }
 
 // Hash ...
@@ -568,6 +644,11 @@ func (Honeycomb) Hash() uint64 {
 	return hashHoneycomb
 }
 
+// Hash ...
+func (Ice) Hash() uint64 {
+	return hashIce
+}
+
 // Hash ...
 func (InvisibleBedrock) Hash() uint64 {
 	return hashInvisibleBedrock
@@ -583,11 +664,21 @@ func (IronBars) Hash() uint64 {
 	return hashIronBars
 }
 
+// Hash ...
+func (d IronDoor) Hash() uint64 {
+	return hashIronDoor | uint64(d.Facing)<<8 | uint64(boolByte(d.Open))<<10 | uint64(boolByte(d.Top))<<11 | uint64(boolByte(d.Right))<<12
+}
+
 // Hash ...
 func (i IronOre) Hash() uint64 {
 	return hashIronOre | uint64(i.Type.Uint8())<<8
 }
 
+// Hash ...
+func (t IronTrapdoor) Hash() uint64 {
+	return hashIronTrapdoor | uint64(t.Facing)<<8 | uint64(boolByte(t.Open))<<10 | uint64(boolByte(t.Top))<<11
+}
+
 // Hash ...
 func (i ItemFrame) Hash() uint64 {
 	return hashItemFrame | uint64(i.Facing)<<8 | uint64(boolByte(i.Glowing))<<11
@@ -638,6 +729,11 @@ func (l Lectern) Hash() uint64 {
 	return hashLectern | uint64(l.Facing)<<8
 }
 
+// Hash ...
+func (l Lever) Hash() uint64 {
+	return hashLever | uint64(boolByte(l.Powered))<<8 | uint64(l.Facing)<<9 | uint64(l.Axis)<<12
+}
+
 // Hash ...
 func (l Light) Hash() uint64 {
 	return hashLight | uint64(l.Level)<<8
@@ -658,6 +754,11 @@ func (l Loom) Hash() uint64 {
 	return hashLoom | uint64(l.Facing)<<8
 }
 
+// Hash ...
+func (m MangrovePropagule) Hash() uint64 {
+	return hashMangrovePropagule | uint64(boolByte(m.Hanging))<<8 | uint64(m.Stage)<<9
+}
+
 // Hash ...
 func (Melon) Hash() uint64 {
 	return hashMelon
@@ -773,6 +874,11 @@ func (p Potato) Hash() uint64 {
 	return hashPotato | uint64(p.Growth)<<8
 }
 
+// Hash ...
+func (r PoweredRail) Hash() uint64 {
+	return hashPoweredRail | uint64(r.Shape.Uint8())<<8 | uint64(boolByte(r.Powered))<<12
+}
+
 // Hash ...
 func (p Prismarine) Hash() uint64 {
 	return hashPrismarine | uint64(p.Type.Uint8())<<8
@@ -813,6 +919,11 @@ func (q QuartzPillar) Hash() uint64 {
 	return hashQuartzPillar | uint64(q.Axis)<<8
 }
 
+// Hash ...
+func (r Rail) Hash() uint64 {
+	return hashRail | uint64(r.Shape.Uint8())<<8
+}
+
 // Hash ...
 func (RawCopper) Hash() uint64 {
 	return hashRawCopper
@@ -828,11 +939,21 @@ func (RawIron) Hash() uint64 {
 	return hashRawIron
 }
 
+// Hash ...
+func (RedstoneBlock) Hash() uint64 {
+	return hashRedstoneBlock
+}
+
 // Hash ...
 func (ReinforcedDeepslate) Hash() uint64 {
 	return hashReinforcedDeepslate
 }
 
+// Hash ...
+func (r RespawnAnchor) Hash() uint64 {
+	return hashRespawnAnchor | uint64(r.Charge)<<8
+}
+
 // Hash ...
 func (s Sand) Hash() uint64 {
 	return hashSand | uint64(boolByte(s.Red))<<8
@@ -843,6 +964,36 @@ func (s Sandstone) Hash() uint64 {
 	return hashSandstone | uint64(s.Type.Uint8())<<8 | uint64(boolByte(s.Red))<<10
 }
 
+// Hash ...
+func (s Sapling) Hash() uint64 {
+	return hashSapling | uint64(s.Wood.Uint8())<<8 | uint64(boolByte(s.Ready))<<12
+}
+
+// Hash ...
+func (s Scaffolding) Hash() uint64 {
+	return hashScaffolding | uint64(s.Stability)<<8
+}
+
+// Hash ...
+func (Sculk) Hash() uint64 {
+	return hashSculk
+}
+
+// Hash ...
+func (s SculkCatalyst) Hash() uint64 {
+	return hashSculkCatalyst | uint64(boolByte(s.Bloom))<<8
+}
+
+// Hash ...
+func (s SculkSensor) Hash() uint64 {
+	return hashSculkSensor | uint64(s.Phase)<<8
+}
+
+// Hash ...
+func (s SculkShrieker) Hash() uint64 {
+	return hashSculkShrieker | uint64(boolByte(s.Active))<<8 | uint64(boolByte(s.CanSummon))<<9
+}
+
 // Hash ...
 func (SeaLantern) Hash() uint64 {
 	return hashSeaLantern
@@ -858,6 +1009,11 @@ func (Shroomlight) Hash() uint64 {
 	return hashShroomlight
 }
 
+// Hash ...
+func (s ShulkerBox) Hash() uint64 {
+	return hashShulkerBox | uint64(s.Type.Uint8())<<8
+}
+
 // Hash ...
 func (s Sign) Hash() uint64 {
 	return hashSign | uint64(s.Wood.Uint8())<<8 | uint64(s.Attach.Uint8())<<12
@@ -880,7 +1036,7 @@ func (SmithingTable) Hash() uint64 {
 
 // Hash ...
 func (s Smoker) Hash() uint64 {
-	return hashSmoker | uint64(s.Facing)<<8 | uint64(boolByte(s.Lit))<<11
+	return hashSmoker | uint64(s.Facing)<<8 | uint64(boolByte(s.Lit))<<10
 }
 
 // Hash ...
@@ -888,6 +1044,11 @@ func (Snow) Hash() uint64 {
 	return hashSnow
 }
 
+// Hash ...
+func (s SnowLayer) Hash() uint64 {
+	return hashSnowLayer | uint64(s.Height)<<8
+}
+
 // Hash ...
 func (SoulSand) Hash() uint64 {
 	return hashSoulSand
@@ -0,0 +1,65 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"math/rand"
+)
+
+// Ice is a translucent solid block formed when water freezes in cold biomes. Ice melts near bright light sources
+// and turns into water when broken without silk touch.
+type Ice struct {
+	solid
+	transparent
+}
+
+// Instrument ...
+func (Ice) Instrument() sound.Instrument {
+	return sound.Chimes()
+}
+
+// RandomTick ...
+func (i Ice) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if w.BlockLight(pos) > 11 {
+		i.melt(pos, w)
+	}
+}
+
+// melt turns the ice at the position passed into water. If the block below is air or if water evaporates in the
+// dimension, the ice is removed instead.
+func (Ice) melt(pos cube.Pos, w *world.World) {
+	if _, air := w.Block(pos.Side(cube.FaceDown)).(Air); air || w.Dimension().WaterEvaporates() {
+		w.SetBlock(pos, nil, nil)
+		return
+	}
+	w.SetBlock(pos, Water{Depth: 8, Still: true}, nil)
+}
+
+// BreakInfo ...
+func (i Ice) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, pickaxeEffective, silkTouchOnlyDrop(i)).withBreakHandler(func(pos cube.Pos, w *world.World, u item.User) {
+		if u != nil {
+			if held, _ := u.HeldItems(); hasSilkTouch(held.Enchantments()) {
+				return
+			}
+		}
+		i.melt(pos, w)
+	})
+}
+
+// Friction ...
+func (Ice) Friction() float64 {
+	return 0.98
+}
+
+// EncodeItem ...
+func (Ice) EncodeItem() (name string, meta int16) {
+	return "minecraft:ice", 0
+}
+
+// EncodeBlock ...
+func (Ice) EncodeBlock() (string, map[string]any) {
+	return "minecraft:ice", nil
+}
@@ -79,6 +79,16 @@ func (l Leaves) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
 	}
 }
 
+// BoneMeal grows a hanging mangrove propagule below mangrove leaves.
+func (l Leaves) BoneMeal(pos cube.Pos, w *world.World) bool {
+	below := pos.Side(cube.FaceDown)
+	if _, ok := w.Block(below).(Air); !ok || l.Wood != Mangrove() {
+		return false
+	}
+	w.SetBlock(below, MangrovePropagule{Hanging: true}, nil)
+	return true
+}
+
 // FlammabilityInfo ...
 func (l Leaves) FlammabilityInfo() FlammabilityInfo {
 	return newFlammabilityInfo(30, 60, true)
@@ -96,7 +106,18 @@ func (l Leaves) BreakInfo() BreakInfo {
 		if (l.Wood == OakWood() || l.Wood == DarkOakWood()) && rand.Float64() < 0.005 {
 			drops = append(drops, item.NewStack(item.Apple{}, 1))
 		}
-		// TODO: Saplings and sticks can drop along with apples
+		if l.Wood != Mangrove() {
+			chance := 0.05
+			if l.Wood == JungleWood() {
+				chance = 0.025
+			}
+			if rand.Float64() < chance {
+				drops = append(drops, item.NewStack(Sapling{Wood: l.Wood}, 1))
+			}
+		}
+		if rand.Float64() < 0.02 {
+			drops = append(drops, item.NewStack(item.Stick{}, rand.Intn(2)+1))
+		}
 		return drops
 	})
 }
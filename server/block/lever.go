@@ -0,0 +1,128 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Lever is a non-solid block that can be switched on and off to provide redstone power to the blocks around it
+// and the block it is attached to.
+type Lever struct {
+	empty
+	transparent
+	flowingWaterDisplacer
+
+	// Powered is whether the lever is switched on and provides redstone power.
+	Powered bool
+	// Facing is the direction from the lever to the block it is attached to.
+	Facing cube.Face
+	// Axis is the horizontal axis the lever is aligned with if it is attached to the top or the bottom of a
+	// block. For levers attached to the side of a block, Axis is ignored and should be left at its zero value.
+	Axis cube.Axis
+}
+
+// WeakPower ...
+func (l Lever) WeakPower(cube.Pos, cube.Face, *world.World) int {
+	if l.Powered {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (l Lever) StrongPower(_ cube.Pos, face cube.Face, _ *world.World) int {
+	if l.Powered && face == l.Facing {
+		return 15
+	}
+	return 0
+}
+
+// Activate ...
+func (l Lever) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	l.Powered = !l.Powered
+	w.SetBlock(pos, l, nil)
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventBlockActivate(), u)
+	if l.Powered {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+		return true
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+	return true
+}
+
+// UseOnBlock ...
+func (l Lever) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, face, used := firstReplaceable(w, pos, face, l)
+	if !used {
+		return false
+	}
+	if !w.Block(pos.Side(face.Opposite())).Model().FaceSolid(pos.Side(face.Opposite()), face, w) {
+		return false
+	}
+	l.Facing = face.Opposite()
+	if face.Axis() == cube.Y {
+		l.Axis = cube.Z
+		if user.Rotation().Direction().Face().Axis() == cube.X {
+			l.Axis = cube.X
+		}
+	}
+
+	place(w, pos, l, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick ...
+func (l Lever) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !w.Block(pos.Side(l.Facing)).Model().FaceSolid(pos.Side(l.Facing), l.Facing.Opposite(), w) {
+		w.SetBlock(pos, nil, nil)
+		dropItem(w, item.NewStack(Lever{}, 1), pos.Vec3Centre())
+	}
+}
+
+// BreakInfo ...
+func (l Lever) BreakInfo() BreakInfo {
+	return newBreakInfo(0.5, alwaysHarvestable, nothingEffective, oneOf(Lever{}))
+}
+
+// EncodeItem ...
+func (l Lever) EncodeItem() (name string, meta int16) {
+	return "minecraft:lever", 0
+}
+
+// EncodeBlock ...
+func (l Lever) EncodeBlock() (string, map[string]any) {
+	var direction string
+	switch l.Facing {
+	case cube.FaceDown:
+		direction = "up_north_south"
+		if l.Axis == cube.X {
+			direction = "up_east_west"
+		}
+	case cube.FaceUp:
+		direction = "down_north_south"
+		if l.Axis == cube.X {
+			direction = "down_east_west"
+		}
+	default:
+		direction = l.Facing.Opposite().String()
+	}
+	return "minecraft:lever", map[string]any{"open_bit": l.Powered, "lever_direction": direction}
+}
+
+// allLevers ...
+func allLevers() (levers []world.Block) {
+	for _, f := range cube.Faces() {
+		for _, powered := range []bool{false, true} {
+			if f.Axis() == cube.Y {
+				levers = append(levers, Lever{Facing: f, Powered: powered, Axis: cube.X})
+				levers = append(levers, Lever{Facing: f, Powered: powered, Axis: cube.Z})
+				continue
+			}
+			levers = append(levers, Lever{Facing: f, Powered: powered})
+		}
+	}
+	return
+}
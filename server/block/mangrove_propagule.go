@@ -0,0 +1,148 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// MangrovePropagule is the sapling of the mangrove tree. Propagules grow underneath mangrove leaves, from where
+// they may be collected once fully grown, and grow into a mangrove tree when planted.
+type MangrovePropagule struct {
+	empty
+	transparent
+
+	// Hanging specifies if the propagule is hanging from mangrove leaves rather than planted in the ground.
+	Hanging bool
+	// Stage is the growth stage of a hanging propagule, ranging from 0 to 4. A hanging propagule with a stage of 4
+	// is fully grown and drops itself when broken.
+	Stage int
+}
+
+// RandomTick ...
+func (m MangrovePropagule) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if m.Hanging {
+		if m.Stage < 4 && r.Intn(7) == 0 {
+			m.Stage++
+			w.SetBlock(pos, m, nil)
+		}
+		return
+	}
+	if w.Light(pos) >= 9 && r.Intn(7) == 0 {
+		m.grow(pos, w, r.Intn)
+	}
+}
+
+// BoneMeal ...
+func (m MangrovePropagule) BoneMeal(pos cube.Pos, w *world.World) bool {
+	if m.Hanging {
+		if m.Stage >= 4 {
+			return false
+		}
+		m.Stage++
+		w.SetBlock(pos, m, nil)
+		return true
+	}
+	if rand.Float64() < 0.45 {
+		m.grow(pos, w, rand.Intn)
+	}
+	return true
+}
+
+// grow attempts to grow the propagule into a mangrove tree.
+func (m MangrovePropagule) grow(pos cube.Pos, w *world.World, intn func(n int) int) {
+	if t, ok := newTree(Mangrove(), false, intn); ok && t.fits(pos, w) {
+		t.grow(pos, w)
+	}
+}
+
+// supported checks if the propagule is supported by the block it is attached to.
+func (m MangrovePropagule) supported(pos cube.Pos, w *world.World) bool {
+	if m.Hanging {
+		leaves, ok := w.Block(pos.Side(cube.FaceUp)).(Leaves)
+		return ok && leaves.Wood == Mangrove()
+	}
+	return supportsVegetation(m, w.Block(pos.Side(cube.FaceDown)))
+}
+
+// NeighbourUpdateTick ...
+func (m MangrovePropagule) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !m.supported(pos, w) {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: m})
+		for _, drop := range m.BreakInfo().Drops(item.ToolNone{}, nil) {
+			dropItem(w, drop, pos.Vec3Centre())
+		}
+	}
+}
+
+// UseOnBlock ...
+func (m MangrovePropagule) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, face, used := firstReplaceable(w, pos, face, m)
+	if !used {
+		return false
+	}
+	// Propagules placed against the bottom of mangrove leaves hang from them fully grown.
+	m = MangrovePropagule{Hanging: face == cube.FaceDown, Stage: 4}
+	if !m.Hanging {
+		m.Stage = 0
+	}
+	if !m.supported(pos, w) {
+		return false
+	}
+
+	place(w, pos, m, user, ctx)
+	return placed(ctx)
+}
+
+// HasLiquidDrops ...
+func (MangrovePropagule) HasLiquidDrops() bool {
+	return true
+}
+
+// FlammabilityInfo ...
+func (MangrovePropagule) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(60, 100, false)
+}
+
+// BreakInfo ...
+func (m MangrovePropagule) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, func(item.Tool, []item.Enchantment) []item.Stack {
+		if m.Hanging && m.Stage < 4 {
+			return nil
+		}
+		return []item.Stack{item.NewStack(MangrovePropagule{}, 1)}
+	})
+}
+
+// FuelInfo ...
+func (MangrovePropagule) FuelInfo() item.FuelInfo {
+	return newFuelInfo(time.Second * 5)
+}
+
+// CompostChance ...
+func (MangrovePropagule) CompostChance() float64 {
+	return 0.3
+}
+
+// EncodeItem ...
+func (MangrovePropagule) EncodeItem() (name string, meta int16) {
+	return "minecraft:mangrove_propagule", 0
+}
+
+// EncodeBlock ...
+func (m MangrovePropagule) EncodeBlock() (string, map[string]any) {
+	return "minecraft:mangrove_propagule", map[string]any{"hanging": boolByte(m.Hanging), "propagule_stage": int32(m.Stage)}
+}
+
+// allMangrovePropagules ...
+func allMangrovePropagules() (propagules []world.Block) {
+	for stage := 0; stage <= 4; stage++ {
+		propagules = append(propagules, MangrovePropagule{Stage: stage}, MangrovePropagule{Hanging: true, Stage: stage})
+	}
+	return
+}
@@ -0,0 +1,94 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Minecart is an item that may be placed on rails to create a minecart, which players may ride.
+type Minecart struct{}
+
+// UseOnBlock ...
+func (Minecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ item.User, ctx *item.UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().Minecart)
+}
+
+// MaxCount ...
+func (Minecart) MaxCount() int {
+	return 1
+}
+
+// EncodeItem ...
+func (Minecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:minecart", 0
+}
+
+// ChestMinecart is an item that may be placed on rails to create a minecart with a chest, which holds 27 stacks
+// of items.
+type ChestMinecart struct{}
+
+// UseOnBlock ...
+func (ChestMinecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ item.User, ctx *item.UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().ChestMinecart)
+}
+
+// MaxCount ...
+func (ChestMinecart) MaxCount() int {
+	return 1
+}
+
+// EncodeItem ...
+func (ChestMinecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:chest_minecart", 0
+}
+
+// HopperMinecart is an item that may be placed on rails to create a minecart with a hopper, which collects items
+// lying on the ground around it.
+type HopperMinecart struct{}
+
+// UseOnBlock ...
+func (HopperMinecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ item.User, ctx *item.UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().HopperMinecart)
+}
+
+// MaxCount ...
+func (HopperMinecart) MaxCount() int {
+	return 1
+}
+
+// EncodeItem ...
+func (HopperMinecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:hopper_minecart", 0
+}
+
+// TNTMinecart is an item that may be placed on rails to create a minecart with TNT, which explodes when it passes
+// over a powered activator rail.
+type TNTMinecart struct{}
+
+// UseOnBlock ...
+func (TNTMinecart) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ item.User, ctx *item.UseContext) bool {
+	return placeMinecart(pos, w, ctx, w.EntityRegistry().Config().TNTMinecart)
+}
+
+// MaxCount ...
+func (TNTMinecart) MaxCount() int {
+	return 1
+}
+
+// EncodeItem ...
+func (TNTMinecart) EncodeItem() (name string, meta int16) {
+	return "minecraft:tnt_minecart", 0
+}
+
+// placeMinecart creates a minecart using the function passed on the track at the position passed. Minecarts
+// may only be placed on tracks.
+func placeMinecart(pos cube.Pos, w *world.World, ctx *item.UseContext, create func(pos mgl64.Vec3) world.Entity) bool {
+	if _, ok := w.Block(pos).(Track); !ok {
+		return false
+	}
+	w.AddEntity(create(pos.Vec3Middle()))
+	ctx.SubtractFromCount(1)
+	return true
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// AmethystCluster is the model of amethyst buds and clusters. Its BBox grows out of the block it is attached to,
+// towards the face it is facing.
+type AmethystCluster struct {
+	// Facing is the face that the cluster grows towards.
+	Facing cube.Face
+	// Height is the height of the cluster, measured from the block it is attached to.
+	Height float64
+	// Inset is the distance between the sides of the cluster and the edges of the block.
+	Inset float64
+}
+
+// BBox ...
+func (a AmethystCluster) BBox(cube.Pos, *world.World) []cube.BBox {
+	i, h := a.Inset, a.Height
+	switch a.Facing {
+	case cube.FaceDown:
+		return []cube.BBox{cube.Box(i, 1-h, i, 1-i, 1, 1-i)}
+	case cube.FaceNorth:
+		return []cube.BBox{cube.Box(i, i, 1-h, 1-i, 1-i, 1)}
+	case cube.FaceSouth:
+		return []cube.BBox{cube.Box(i, i, 0, 1-i, 1-i, h)}
+	case cube.FaceWest:
+		return []cube.BBox{cube.Box(1-h, i, i, 1, 1-i, 1-i)}
+	case cube.FaceEast:
+		return []cube.BBox{cube.Box(0, i, i, h, 1-i, 1-i)}
+	}
+	return []cube.BBox{cube.Box(i, 0, i, 1-i, h, 1-i)}
+}
+
+// FaceSolid ...
+func (AmethystCluster) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
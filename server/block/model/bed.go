@@ -0,0 +1,19 @@
+package model
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Bed is a model used for beds. This model works for both parts of the bed.
+type Bed struct{}
+
+// BBox returns a BBox with a height of 0.5625.
+func (Bed) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{cube.Box(0, 0, 0, 1, 0.5625, 1)}
+}
+
+// FaceSolid always returns false.
+func (Bed) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// BrewingStand is a model used by brewing stands. It consists of a thin base and a rod in the centre.
+type BrewingStand struct{}
+
+// BBox returns the base of the brewing stand and the rod in its centre.
+func (BrewingStand) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{
+		cube.Box(0, 0, 0, 1, 0.125, 1),
+		cube.Box(0.4375, 0, 0.4375, 0.5625, 0.875, 0.5625),
+	}
+}
+
+// FaceSolid always returns false.
+func (BrewingStand) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
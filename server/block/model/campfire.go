@@ -0,0 +1,19 @@
+package model
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Campfire is the model used by campfires.
+type Campfire struct{}
+
+// BBox ...
+func (Campfire) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{cube.Box(0, 0, 0, 1, 0.4375, 1)}
+}
+
+// FaceSolid ...
+func (Campfire) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Scaffolding is the model used by scaffolding. Only the top platform of scaffolding has collision, so that
+// entities may climb up and sneak down through it.
+type Scaffolding struct{}
+
+// BBox ...
+func (Scaffolding) BBox(cube.Pos, *world.World) []cube.BBox {
+	return []cube.BBox{cube.Box(0, 0.875, 0, 1, 1, 1)}
+}
+
+// FaceSolid ...
+func (Scaffolding) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return false
+}
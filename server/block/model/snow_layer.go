@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// SnowLayer is the model of a snow layer. The height of its BBox is one layer lower than the layers visible, so that
+// a single layer of snow has no collision.
+type SnowLayer struct {
+	// Height is the height of the snow layer, ranging from 0 to 7.
+	Height int
+}
+
+// BBox ...
+func (s SnowLayer) BBox(cube.Pos, *world.World) []cube.BBox {
+	if s.Height == 0 {
+		return nil
+	}
+	return []cube.BBox{cube.Box(0, 0, 0, 1, float64(s.Height)/8, 1)}
+}
+
+// FaceSolid ...
+func (s SnowLayer) FaceSolid(cube.Pos, cube.Face, *world.World) bool {
+	return s.Height == 7
+}
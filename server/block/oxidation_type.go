@@ -0,0 +1,85 @@
+package block
+
+// OxidationType represents a stage of oxidation of copper blocks. Copper oxidises slowly over time, changing its
+// colour from orange to green.
+type OxidationType struct {
+	oxidation
+}
+
+// UnoxidisedOxidation returns the unoxidised oxidation stage: Copper that has not oxidised at all.
+func UnoxidisedOxidation() OxidationType {
+	return OxidationType{0}
+}
+
+// ExposedOxidation returns the exposed oxidation stage.
+func ExposedOxidation() OxidationType {
+	return OxidationType{1}
+}
+
+// WeatheredOxidation returns the weathered oxidation stage.
+func WeatheredOxidation() OxidationType {
+	return OxidationType{2}
+}
+
+// OxidisedOxidation returns the oxidised oxidation stage: Copper that has fully oxidised.
+func OxidisedOxidation() OxidationType {
+	return OxidationType{3}
+}
+
+// OxidationTypes returns a list of all oxidation stages.
+func OxidationTypes() []OxidationType {
+	return []OxidationType{UnoxidisedOxidation(), ExposedOxidation(), WeatheredOxidation(), OxidisedOxidation()}
+}
+
+type oxidation uint8
+
+// Uint8 returns the oxidation as a uint8.
+func (o oxidation) Uint8() uint8 {
+	return uint8(o)
+}
+
+// Name ...
+func (o oxidation) Name() string {
+	switch o {
+	case 0:
+		return "Unoxidised"
+	case 1:
+		return "Exposed"
+	case 2:
+		return "Weathered"
+	case 3:
+		return "Oxidised"
+	}
+	panic("unknown oxidation type")
+}
+
+// String ...
+func (o oxidation) String() string {
+	switch o {
+	case 0:
+		return "unoxidised"
+	case 1:
+		return "exposed"
+	case 2:
+		return "weathered"
+	case 3:
+		return "oxidized"
+	}
+	panic("unknown oxidation type")
+}
+
+// Increase returns the next oxidation stage. If the oxidation is already fully oxidised, false is returned.
+func (o oxidation) Increase() (OxidationType, bool) {
+	if o >= 3 {
+		return OxidationType{o}, false
+	}
+	return OxidationType{o + 1}, true
+}
+
+// Decrease returns the previous oxidation stage. If the oxidation is unoxidised, false is returned.
+func (o oxidation) Decrease() (OxidationType, bool) {
+	if o == 0 {
+		return OxidationType{o}, false
+	}
+	return OxidationType{o - 1}, true
+}
@@ -0,0 +1,157 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// PoweredRail is a rail that speeds up minecarts riding over it while powered by redstone, and slows them down
+// while unpowered. Power is passed on through a line of up to eight connected powered rails.
+type PoweredRail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail. Powered rails cannot be curved.
+	Shape RailShape
+	// Powered is whether the rail is powered by redstone.
+	Powered bool
+}
+
+// RailShape ...
+func (r PoweredRail) RailShape() RailShape {
+	return r.Shape
+}
+
+// WithRailShape ...
+func (r PoweredRail) WithRailShape(s RailShape) Track {
+	r.Shape = s
+	return r
+}
+
+// UseOnBlock ...
+func (r PoweredRail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, placed := placeTrack(pos, face, w, user, ctx, PoweredRail{Shape: r.Shape})
+	if placed {
+		updateTrackPower[PoweredRail](pos, w)
+	}
+	return placed
+}
+
+// NeighbourUpdateTick ...
+func (r PoweredRail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	breakUnsupportedTrack(pos, w, PoweredRail{})
+	updateTrackPower[PoweredRail](pos, w)
+}
+
+// RedstoneUpdate ...
+func (r PoweredRail) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	updateTrackPower[PoweredRail](pos, w)
+}
+
+// HasLiquidDrops ...
+func (PoweredRail) HasLiquidDrops() bool {
+	return true
+}
+
+// SideClosed ...
+func (PoweredRail) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (r PoweredRail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(PoweredRail{}))
+}
+
+// EncodeItem ...
+func (PoweredRail) EncodeItem() (name string, meta int16) {
+	return "minecraft:golden_rail", 0
+}
+
+// EncodeBlock ...
+func (r PoweredRail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:golden_rail", map[string]any{"rail_direction": int32(r.Shape.Uint8()), "rail_data_bit": r.Powered}
+}
+
+// powered ...
+func (r PoweredRail) powered() bool {
+	return r.Powered
+}
+
+// withPowered ...
+func (r PoweredRail) withPowered(powered bool) poweredTrack {
+	r.Powered = powered
+	return r
+}
+
+// allPoweredRails ...
+func allPoweredRails() (rails []world.Block) {
+	for _, s := range StraightRailShapes() {
+		rails = append(rails, PoweredRail{Shape: s}, PoweredRail{Shape: s, Powered: true})
+	}
+	return
+}
+
+// poweredTrack is a Track that is powered through a line of connected tracks of the same type, such as a
+// PoweredRail or an ActivatorRail.
+type poweredTrack interface {
+	Track
+	powered() bool
+	withPowered(powered bool) poweredTrack
+}
+
+// maxTrackPowerDistance is the maximum number of tracks that redstone power is passed on through.
+const maxTrackPowerDistance = 8
+
+// updateTrackPower updates the powered state of the track of type T at the position passed and of all tracks of
+// the same type connected to it in a line, within the distance that power may be passed on over.
+func updateTrackPower[T poweredTrack](pos cube.Pos, w *world.World) {
+	for _, p := range trackLine[T](pos, w) {
+		t := w.Block(p).(T)
+		if powered := trackLinePowered[T](p, w); powered != t.powered() {
+			w.SetBlock(p, t.withPowered(powered), nil)
+		}
+	}
+}
+
+// trackLinePowered checks if any track in the line of tracks of type T connected to the position passed
+// receives redstone power.
+func trackLinePowered[T poweredTrack](pos cube.Pos, w *world.World) bool {
+	for _, p := range trackLine[T](pos, w) {
+		if w.RedstonePower(p) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// trackLine returns the positions of all tracks of type T connected to the track at the position passed, up to
+// maxTrackPowerDistance tracks away. The position passed is included if it holds a track of type T.
+func trackLine[T poweredTrack](pos cube.Pos, w *world.World) []cube.Pos {
+	t, ok := w.Block(pos).(T)
+	if !ok {
+		return nil
+	}
+	line := []cube.Pos{pos}
+	for _, d := range t.RailShape().Connections() {
+		prev, current, dir := pos, pos, d
+		for i := 0; i < maxTrackPowerDistance; i++ {
+			npos, n, ok := trackNeighbour(current, dir, w)
+			next, same := n.(T)
+			if !ok || !same || !trackConnectsTo(npos, next, current) || npos == prev {
+				break
+			}
+			line = append(line, npos)
+			prev, current = current, npos
+			for _, c := range next.RailShape().Connections() {
+				if c != dir.Opposite() {
+					dir = c
+					break
+				}
+			}
+		}
+	}
+	return line
+}
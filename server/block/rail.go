@@ -0,0 +1,223 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Rail is a non-solid block that minecarts ride on. Rails automatically connect to the rails around them when
+// placed, forming straight, sloped or curved tracks.
+type Rail struct {
+	empty
+	transparent
+
+	// Shape is the shape of the rail, which decides the directions it connects to.
+	Shape RailShape
+}
+
+// Track represents a rail block that minecarts can ride on, such as a Rail or a PoweredRail.
+type Track interface {
+	world.Block
+	// RailShape returns the shape of the track.
+	RailShape() RailShape
+	// WithRailShape returns the track with its shape changed to the shape passed.
+	WithRailShape(s RailShape) Track
+}
+
+// Cart represents an entity that rides on rails, such as a minecart. Detector rails are powered while a Cart is on
+// them.
+type Cart interface {
+	world.Entity
+	// Rail returns the position of the rail that the Cart is currently on. False is returned if the Cart is not
+	// on a rail.
+	Rail() (cube.Pos, bool)
+}
+
+// RailShape ...
+func (r Rail) RailShape() RailShape {
+	return r.Shape
+}
+
+// WithRailShape ...
+func (r Rail) WithRailShape(s RailShape) Track {
+	r.Shape = s
+	return r
+}
+
+// UseOnBlock ...
+func (r Rail) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	_, placed := placeTrack(pos, face, w, user, ctx, r)
+	return placed
+}
+
+// NeighbourUpdateTick ...
+func (r Rail) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	breakUnsupportedTrack(pos, w, Rail{})
+}
+
+// HasLiquidDrops ...
+func (Rail) HasLiquidDrops() bool {
+	return true
+}
+
+// SideClosed ...
+func (Rail) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// BreakInfo ...
+func (r Rail) BreakInfo() BreakInfo {
+	return newBreakInfo(0.7, alwaysHarvestable, pickaxeEffective, oneOf(Rail{}))
+}
+
+// EncodeItem ...
+func (Rail) EncodeItem() (name string, meta int16) {
+	return "minecraft:rail", 0
+}
+
+// EncodeBlock ...
+func (r Rail) EncodeBlock() (string, map[string]any) {
+	return "minecraft:rail", map[string]any{"rail_direction": int32(r.Shape.Uint8())}
+}
+
+// allRails ...
+func allRails() (rails []world.Block) {
+	for _, s := range RailShapes() {
+		rails = append(rails, Rail{Shape: s})
+	}
+	return
+}
+
+// placeTrack places the track passed at the position clicked, shaping it so that it connects to the tracks
+// around it. The tracks it connects to are reshaped to connect to it, too. The position that the track was placed
+// at is returned.
+func placeTrack(pos cube.Pos, face cube.Face, w *world.World, user item.User, ctx *item.UseContext, t Track) (cube.Pos, bool) {
+	pos, _, used := firstReplaceable(w, pos, face, t)
+	if !used || !trackSupported(pos, w) {
+		return pos, false
+	}
+	shape := RailNorthSouth()
+	if user.Rotation().Direction().Face().Axis() == cube.X {
+		shape = RailEastWest()
+	}
+	t = t.WithRailShape(trackShape(pos, w, t, shape))
+
+	place(w, pos, t, user, ctx)
+	if !placed(ctx) {
+		return pos, false
+	}
+	for _, d := range t.RailShape().Connections() {
+		npos, n, ok := trackNeighbour(pos, d, w)
+		if !ok || trackConnectsTo(npos, n, pos) {
+			continue
+		}
+		if s := trackShape(npos, w, n, n.RailShape()); s != n.RailShape() {
+			w.SetBlock(npos, n.WithRailShape(s), nil)
+		}
+	}
+	return pos, true
+}
+
+// trackShape returns the shape that the track passed should have at the position passed to connect to the
+// tracks around it. If no tracks around it can be connected to, current is returned.
+func trackShape(pos cube.Pos, w *world.World, t Track, current RailShape) RailShape {
+	var found, raised [4]bool
+	for _, d := range cube.Directions() {
+		npos, n, ok := trackNeighbour(pos, d, w)
+		if !ok || !trackConnectable(npos, n, pos, w) {
+			continue
+		}
+		found[d], raised[d] = true, npos[1] > pos[1]
+	}
+	_, curvable := t.(Rail)
+	pairs := [][2]cube.Direction{{cube.North, cube.South}, {cube.East, cube.West}}
+	if curvable {
+		pairs = append(pairs, [][2]cube.Direction{{cube.South, cube.East}, {cube.South, cube.West}, {cube.North, cube.West}, {cube.North, cube.East}}...)
+	}
+	for _, p := range pairs {
+		if !found[p[0]] || !found[p[1]] {
+			continue
+		}
+		if p[0].Opposite() == p[1] {
+			if raised[p[1]] {
+				return railShapeOf(p[1], p[0], true)
+			}
+			return railShapeOf(p[0], p[1], raised[p[0]])
+		}
+		return railShapeOf(p[0], p[1], false)
+	}
+	// Only one track, or two tracks that cannot be connected at once, may be connected to. A track that it is
+	// already connected to is preferred.
+	for _, d := range cube.Directions() {
+		if found[d] && raised[d] {
+			return railShapeOf(d, d.Opposite(), true)
+		}
+	}
+	for _, d := range cube.Directions() {
+		if _, asc := current.Ascending(); found[d] && !asc && current.Connects(d) {
+			return current
+		}
+	}
+	for _, d := range cube.Directions() {
+		if found[d] {
+			return railShapeOf(d, d.Opposite(), false)
+		}
+	}
+	return current
+}
+
+// trackNeighbour returns the track next to the position passed in the direction passed. Tracks one block higher
+// or lower are also returned, as they may be connected to through a slope.
+func trackNeighbour(pos cube.Pos, d cube.Direction, w *world.World) (cube.Pos, Track, bool) {
+	side := pos.Side(d.Face())
+	for _, npos := range []cube.Pos{side, side.Side(cube.FaceUp), side.Side(cube.FaceDown)} {
+		if t, ok := w.Block(npos).(Track); ok {
+			return npos, t, true
+		}
+	}
+	return cube.Pos{}, nil, false
+}
+
+// trackConnectsTo checks if the track passed, placed at pos, has a connection pointing towards the position
+// target.
+func trackConnectsTo(pos cube.Pos, t Track, target cube.Pos) bool {
+	for _, d := range t.RailShape().Connections() {
+		if side := pos.Side(d.Face()); side[0] == target[0] && side[2] == target[2] {
+			return true
+		}
+	}
+	return false
+}
+
+// trackConnectable checks if the track passed, placed at pos, is connected to or may be reshaped to connect to
+// the position target. Tracks that are already connected to two other tracks cannot be reshaped.
+func trackConnectable(pos cube.Pos, t Track, target cube.Pos, w *world.World) bool {
+	if trackConnectsTo(pos, t, target) {
+		return true
+	}
+	connected := 0
+	for _, d := range t.RailShape().Connections() {
+		if npos, n, ok := trackNeighbour(pos, d, w); ok && trackConnectsTo(npos, n, pos) {
+			connected++
+		}
+	}
+	return connected < 2
+}
+
+// trackSupported checks if a track may stay at the position passed, which is the case if the block below it
+// has a solid top face.
+func trackSupported(pos cube.Pos, w *world.World) bool {
+	below := pos.Side(cube.FaceDown)
+	return w.Block(below).Model().FaceSolid(below, cube.FaceUp, w)
+}
+
+// breakUnsupportedTrack breaks the track at the position passed if it is no longer supported by the block below
+// it, dropping the item passed.
+func breakUnsupportedTrack(pos cube.Pos, w *world.World, drop world.Item) {
+	if !trackSupported(pos, w) {
+		w.SetBlock(pos, nil, nil)
+		dropItem(w, item.NewStack(drop, 1), pos.Vec3Centre())
+	}
+}
@@ -0,0 +1,172 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+)
+
+// RailShape represents the shape of a rail block, which decides the directions in which the rail connects to
+// other rails. Only plain rails may take a curved shape.
+type RailShape struct {
+	railShape
+}
+
+// RailNorthSouth returns a straight rail shape connecting north and south.
+func RailNorthSouth() RailShape {
+	return RailShape{0}
+}
+
+// RailEastWest returns a straight rail shape connecting east and west.
+func RailEastWest() RailShape {
+	return RailShape{1}
+}
+
+// RailAscendingEast returns a sloped rail shape that rises towards the east.
+func RailAscendingEast() RailShape {
+	return RailShape{2}
+}
+
+// RailAscendingWest returns a sloped rail shape that rises towards the west.
+func RailAscendingWest() RailShape {
+	return RailShape{3}
+}
+
+// RailAscendingNorth returns a sloped rail shape that rises towards the north.
+func RailAscendingNorth() RailShape {
+	return RailShape{4}
+}
+
+// RailAscendingSouth returns a sloped rail shape that rises towards the south.
+func RailAscendingSouth() RailShape {
+	return RailShape{5}
+}
+
+// RailSouthEast returns a curved rail shape connecting south and east.
+func RailSouthEast() RailShape {
+	return RailShape{6}
+}
+
+// RailSouthWest returns a curved rail shape connecting south and west.
+func RailSouthWest() RailShape {
+	return RailShape{7}
+}
+
+// RailNorthWest returns a curved rail shape connecting north and west.
+func RailNorthWest() RailShape {
+	return RailShape{8}
+}
+
+// RailNorthEast returns a curved rail shape connecting north and east.
+func RailNorthEast() RailShape {
+	return RailShape{9}
+}
+
+// RailShapes returns all rail shapes, including curved ones.
+func RailShapes() []RailShape {
+	return []RailShape{RailNorthSouth(), RailEastWest(), RailAscendingEast(), RailAscendingWest(), RailAscendingNorth(), RailAscendingSouth(), RailSouthEast(), RailSouthWest(), RailNorthWest(), RailNorthEast()}
+}
+
+// StraightRailShapes returns all rail shapes that are not curved. These are the only shapes that powered,
+// detector and activator rails may take.
+func StraightRailShapes() []RailShape {
+	return RailShapes()[:6]
+}
+
+type railShape uint8
+
+// Uint8 returns the rail shape as a uint8.
+func (s railShape) Uint8() uint8 {
+	return uint8(s)
+}
+
+// Curved checks if the rail shape is curved, connecting two perpendicular directions.
+func (s railShape) Curved() bool {
+	return s >= 6
+}
+
+// Ascending returns the direction that the rail shape rises towards. False is returned if the shape is flat.
+func (s railShape) Ascending() (cube.Direction, bool) {
+	switch s {
+	case 2:
+		return cube.East, true
+	case 3:
+		return cube.West, true
+	case 4:
+		return cube.North, true
+	case 5:
+		return cube.South, true
+	}
+	return 0, false
+}
+
+// Connections returns the two directions that a rail of the shape connects to.
+func (s railShape) Connections() [2]cube.Direction {
+	switch s {
+	case 0, 4, 5:
+		return [2]cube.Direction{cube.North, cube.South}
+	case 1, 2, 3:
+		return [2]cube.Direction{cube.West, cube.East}
+	case 6:
+		return [2]cube.Direction{cube.South, cube.East}
+	case 7:
+		return [2]cube.Direction{cube.South, cube.West}
+	case 8:
+		return [2]cube.Direction{cube.North, cube.West}
+	}
+	return [2]cube.Direction{cube.North, cube.East}
+}
+
+// Connects checks if the rail shape connects to the direction passed.
+func (s railShape) Connects(d cube.Direction) bool {
+	c := s.Connections()
+	return c[0] == d || c[1] == d
+}
+
+// Name ...
+func (s railShape) Name() string {
+	switch s {
+	case 0:
+		return "North South"
+	case 1:
+		return "East West"
+	case 2:
+		return "Ascending East"
+	case 3:
+		return "Ascending West"
+	case 4:
+		return "Ascending North"
+	case 5:
+		return "Ascending South"
+	case 6:
+		return "South East"
+	case 7:
+		return "South West"
+	case 8:
+		return "North West"
+	}
+	return "North East"
+}
+
+// railShapeOf returns the rail shape that connects the two directions passed. If ascending is true, the shape
+// rises towards the first direction. Straight shapes are returned for the ascending case only.
+func railShapeOf(a, b cube.Direction, ascending bool) RailShape {
+	if ascending {
+		switch a {
+		case cube.East:
+			return RailAscendingEast()
+		case cube.West:
+			return RailAscendingWest()
+		case cube.North:
+			return RailAscendingNorth()
+		}
+		return RailAscendingSouth()
+	}
+	for _, s := range RailShapes() {
+		if _, asc := s.Ascending(); !asc && s.Connects(a) && s.Connects(b) && a != b {
+			return s
+		}
+	}
+	if a.Face().Axis() == cube.X {
+		return RailEastWest()
+	}
+	return RailNorthSouth()
+}
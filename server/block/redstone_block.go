@@ -0,0 +1,37 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// RedstoneBlock is a mineral block made from redstone dust. It acts as a permanent source of redstone power to
+// the blocks directly around it.
+type RedstoneBlock struct {
+	solid
+}
+
+// WeakPower ...
+func (RedstoneBlock) WeakPower(cube.Pos, cube.Face, *world.World) int {
+	return 15
+}
+
+// StrongPower ...
+func (RedstoneBlock) StrongPower(cube.Pos, cube.Face, *world.World) int {
+	return 0
+}
+
+// BreakInfo ...
+func (r RedstoneBlock) BreakInfo() BreakInfo {
+	return newBreakInfo(5, pickaxeHarvestable, pickaxeEffective, oneOf(r)).withBlastResistance(30)
+}
+
+// EncodeItem ...
+func (RedstoneBlock) EncodeItem() (name string, meta int16) {
+	return "minecraft:redstone_block", 0
+}
+
+// EncodeBlock ...
+func (RedstoneBlock) EncodeBlock() (string, map[string]any) {
+	return "minecraft:redstone_block", nil
+}
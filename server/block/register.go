@@ -21,6 +21,7 @@ func init() {
 	world.RegisterBlock(BlueIce{})
 	world.RegisterBlock(Bookshelf{})
 	world.RegisterBlock(Bricks{})
+	world.RegisterBlock(BuddingAmethyst{})
 	world.RegisterBlock(Calcite{})
 	world.RegisterBlock(Clay{})
 	world.RegisterBlock(Coal{})
@@ -55,6 +56,7 @@ func init() {
 	world.RegisterBlock(Grass{})
 	world.RegisterBlock(Gravel{})
 	world.RegisterBlock(Honeycomb{})
+	world.RegisterBlock(Ice{})
 	world.RegisterBlock(InvisibleBedrock{})
 	world.RegisterBlock(IronBars{})
 	world.RegisterBlock(Iron{})
@@ -84,9 +86,13 @@ func init() {
 	world.RegisterBlock(RawCopper{})
 	world.RegisterBlock(RawGold{})
 	world.RegisterBlock(RawIron{})
+	world.RegisterBlock(RedstoneBlock{})
 	world.RegisterBlock(ReinforcedDeepslate{})
 	world.RegisterBlock(Sand{Red: true})
 	world.RegisterBlock(Sand{})
+	world.RegisterBlock(Sculk{})
+	world.RegisterBlock(SculkCatalyst{Bloom: true})
+	world.RegisterBlock(SculkCatalyst{})
 	world.RegisterBlock(SeaLantern{})
 	world.RegisterBlock(Shroomlight{})
 	world.RegisterBlock(SmithingTable{})
@@ -112,17 +118,24 @@ func init() {
 		world.RegisterBlock(LapisOre{Type: ore})
 	}
 
+	registerAll(allActivatorRails())
+	registerAll(allAmethystClusters())
 	registerAll(allAnvils())
 	registerAll(allBanners())
 	registerAll(allBarrels())
 	registerAll(allBasalt())
+	registerAll(allBeds())
+	registerAll(allBeehives())
 	registerAll(allBeetroot())
 	registerAll(allBlackstone())
 	registerAll(allBlastFurnaces())
 	registerAll(allBoneBlock())
+	registerAll(allBrewingStands())
 	registerAll(allCactus())
 	registerAll(allCake())
+	registerAll(allCampfires())
 	registerAll(allCarpet())
+	registerAll(allCauldrons())
 	registerAll(allCarrots())
 	registerAll(allChains())
 	registerAll(allChests())
@@ -130,9 +143,11 @@ func init() {
 	registerAll(allComposters())
 	registerAll(allConcrete())
 	registerAll(allConcretePowder())
+	registerAll(allCopper())
 	registerAll(allCoral())
 	registerAll(allCoralBlocks())
 	registerAll(allDeepslate())
+	registerAll(allDetectorRails())
 	registerAll(allDoors())
 	registerAll(allDoubleFlowers())
 	registerAll(allDoubleTallGrass())
@@ -148,33 +163,46 @@ func init() {
 	registerAll(allGrindstones())
 	registerAll(allHayBales())
 	registerAll(allItemFrames())
+	registerAll(allIronDoors())
+	registerAll(allIronTrapdoors())
 	registerAll(allKelp())
 	registerAll(allLadders())
 	registerAll(allLanterns())
 	registerAll(allLava())
 	registerAll(allLeaves())
 	registerAll(allLecterns())
+	registerAll(allLevers())
 	registerAll(allLight())
 	registerAll(allLitPumpkins())
 	registerAll(allLogs())
 	registerAll(allLooms())
+	registerAll(allMangrovePropagules())
 	registerAll(allMelonStems())
 	registerAll(allMuddyMangroveRoots())
 	registerAll(allNetherBricks())
 	registerAll(allNetherWart())
 	registerAll(allPlanks())
 	registerAll(allPotato())
+	registerAll(allPoweredRails())
 	registerAll(allPrismarine())
 	registerAll(allPumpkinStems())
 	registerAll(allPumpkins())
 	registerAll(allPurpurs())
 	registerAll(allQuartz())
+	registerAll(allRails())
+	registerAll(allRespawnAnchors())
 	registerAll(allSandstones())
+	registerAll(allSaplings())
+	registerAll(allScaffolding())
+	registerAll(allSculkSensors())
+	registerAll(allSculkShriekers())
 	registerAll(allSeaPickles())
+	registerAll(allShulkerBoxes())
 	registerAll(allSigns())
 	registerAll(allSkulls())
 	registerAll(allSlabs())
 	registerAll(allSmokers())
+	registerAll(allSnowLayers())
 	registerAll(allStainedGlass())
 	registerAll(allStainedGlassPane())
 	registerAll(allStainedTerracotta())
@@ -194,6 +222,7 @@ func init() {
 }
 
 func init() {
+	world.RegisterItem(ActivatorRail{})
 	world.RegisterItem(Air{})
 	world.RegisterItem(Amethyst{})
 	world.RegisterItem(AncientDebris{})
@@ -205,17 +234,23 @@ func init() {
 	world.RegisterItem(Basalt{})
 	world.RegisterItem(Beacon{})
 	world.RegisterItem(Bedrock{})
+	world.RegisterItem(Beehive{Nest: true})
+	world.RegisterItem(Beehive{})
 	world.RegisterItem(BeetrootSeeds{})
 	world.RegisterItem(BlastFurnace{})
 	world.RegisterItem(BlueIce{})
 	world.RegisterItem(Bone{})
 	world.RegisterItem(Bookshelf{})
+	world.RegisterItem(BrewingStand{})
 	world.RegisterItem(Bricks{})
+	world.RegisterItem(BuddingAmethyst{})
 	world.RegisterItem(Cactus{})
 	world.RegisterItem(Cake{})
 	world.RegisterItem(Calcite{})
 	world.RegisterItem(Carrot{})
+	world.RegisterItem(Cauldron{})
 	world.RegisterItem(Chain{})
+	world.RegisterItem(ChestMinecart{})
 	world.RegisterItem(Chest{})
 	world.RegisterItem(ChiseledQuartz{})
 	world.RegisterItem(Clay{})
@@ -230,6 +265,7 @@ func init() {
 	world.RegisterItem(DeepslateBricks{})
 	world.RegisterItem(DeepslateTiles{Cracked: true})
 	world.RegisterItem(DeepslateTiles{})
+	world.RegisterItem(DetectorRail{})
 	world.RegisterItem(Diamond{})
 	world.RegisterItem(Diorite{Polished: true})
 	world.RegisterItem(Diorite{})
@@ -257,8 +293,12 @@ func init() {
 	world.RegisterItem(Grindstone{})
 	world.RegisterItem(HayBale{})
 	world.RegisterItem(Honeycomb{})
+	world.RegisterItem(HopperMinecart{})
+	world.RegisterItem(Ice{})
 	world.RegisterItem(InvisibleBedrock{})
 	world.RegisterItem(IronBars{})
+	world.RegisterItem(IronDoor{})
+	world.RegisterItem(IronTrapdoor{})
 	world.RegisterItem(Iron{})
 	world.RegisterItem(ItemFrame{Glowing: true})
 	world.RegisterItem(ItemFrame{})
@@ -267,10 +307,13 @@ func init() {
 	world.RegisterItem(Ladder{})
 	world.RegisterItem(Lapis{})
 	world.RegisterItem(Lectern{})
+	world.RegisterItem(Lever{})
 	world.RegisterItem(LitPumpkin{})
 	world.RegisterItem(Loom{})
+	world.RegisterItem(MangrovePropagule{})
 	world.RegisterItem(MelonSeeds{})
 	world.RegisterItem(Melon{})
+	world.RegisterItem(Minecart{})
 	world.RegisterItem(MossCarpet{})
 	world.RegisterItem(MudBricks{})
 	world.RegisterItem(MuddyMangroveRoots{})
@@ -293,6 +336,7 @@ func init() {
 	world.RegisterItem(PolishedBlackstoneBrick{Cracked: true})
 	world.RegisterItem(PolishedBlackstoneBrick{})
 	world.RegisterItem(Potato{})
+	world.RegisterItem(PoweredRail{})
 	world.RegisterItem(PumpkinSeeds{})
 	world.RegisterItem(Pumpkin{Carved: true})
 	world.RegisterItem(Pumpkin{})
@@ -302,17 +346,26 @@ func init() {
 	world.RegisterItem(QuartzPillar{})
 	world.RegisterItem(Quartz{Smooth: true})
 	world.RegisterItem(Quartz{})
+	world.RegisterItem(Rail{})
 	world.RegisterItem(RawCopper{})
 	world.RegisterItem(RawGold{})
 	world.RegisterItem(RawIron{})
+	world.RegisterItem(RedstoneBlock{})
 	world.RegisterItem(ReinforcedDeepslate{})
+	world.RegisterItem(RespawnAnchor{})
 	world.RegisterItem(Sand{Red: true})
 	world.RegisterItem(Sand{})
+	world.RegisterItem(Scaffolding{})
+	world.RegisterItem(SculkCatalyst{})
+	world.RegisterItem(SculkSensor{})
+	world.RegisterItem(SculkShrieker{})
+	world.RegisterItem(Sculk{})
 	world.RegisterItem(SeaLantern{})
 	world.RegisterItem(SeaPickle{})
 	world.RegisterItem(Shroomlight{})
 	world.RegisterItem(SmithingTable{})
 	world.RegisterItem(Smoker{})
+	world.RegisterItem(SnowLayer{})
 	world.RegisterItem(Snow{})
 	world.RegisterItem(SoulSand{})
 	world.RegisterItem(SoulSoil{})
@@ -323,6 +376,7 @@ func init() {
 	world.RegisterItem(Stone{Smooth: true})
 	world.RegisterItem(Stone{})
 	world.RegisterItem(SugarCane{})
+	world.RegisterItem(TNTMinecart{})
 	world.RegisterItem(TNT{})
 	world.RegisterItem(Terracotta{})
 	world.RegisterItem(Tuff{})
@@ -335,6 +389,9 @@ func init() {
 	for _, b := range allLight() {
 		world.RegisterItem(b.(world.Item))
 	}
+	for _, c := range allCopper() {
+		world.RegisterItem(c.(world.Item))
+	}
 	for _, c := range allCoral() {
 		world.RegisterItem(c.(world.Item))
 	}
@@ -351,8 +408,12 @@ func init() {
 	for _, t := range AnvilTypes() {
 		world.RegisterItem(Anvil{Type: t})
 	}
+	for _, t := range ShulkerBoxTypes() {
+		world.RegisterItem(ShulkerBox{Type: t})
+	}
 	for _, c := range item.Colours() {
 		world.RegisterItem(Banner{Colour: c})
+		world.RegisterItem(Bed{Colour: c})
 		world.RegisterItem(Carpet{Colour: c})
 		world.RegisterItem(ConcretePowder{Colour: c})
 		world.RegisterItem(Concrete{Colour: c})
@@ -362,6 +423,13 @@ func init() {
 		world.RegisterItem(StainedTerracotta{Colour: c})
 		world.RegisterItem(Wool{Colour: c})
 	}
+	for _, w := range saplingWoodTypes() {
+		world.RegisterItem(Sapling{Wood: w})
+	}
+	for _, w := range boatWoodTypes() {
+		world.RegisterItem(Boat{Wood: w, Chest: true})
+		world.RegisterItem(Boat{Wood: w})
+	}
 	for _, w := range WoodTypes() {
 		if w != WarpedWood() && w != CrimsonWood() {
 			world.RegisterItem(Leaves{Wood: w, Persistent: true})
@@ -386,7 +454,11 @@ func init() {
 		world.RegisterItem(IronOre{Type: ore})
 		world.RegisterItem(LapisOre{Type: ore})
 	}
+	for stage := 0; stage <= maxAmethystStage; stage++ {
+		world.RegisterItem(AmethystCluster{Stage: stage})
+	}
 	for _, f := range FireTypes() {
+		world.RegisterItem(Campfire{Type: f})
 		world.RegisterItem(Lantern{Type: f})
 		world.RegisterItem(Torch{Type: f})
 	}
@@ -0,0 +1,100 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/google/uuid"
+)
+
+// RespawnAnchor is a block that allows players to set their spawn point in the Nether. It must be charged with
+// glowstone before it can be used, and every respawn at the anchor uses up one of its charges. Respawn anchors
+// explode when used outside the Nether.
+type RespawnAnchor struct {
+	solid
+	bassDrum
+
+	// Charge is the amount of charges of the respawn anchor. It is a number from 0-4.
+	Charge int
+}
+
+// spawnSetter is an item.User that may have its spawn point set by a respawn anchor.
+type spawnSetter interface {
+	UUID() uuid.UUID
+	SendTranslation(key string, a ...string)
+}
+
+// spawnBlockTracker is a spawnSetter that keeps track of the bed or respawn anchor that its spawn point was set
+// with, so that the spawn point may be reset once the block is no longer present.
+type spawnBlockTracker interface {
+	// TrackSpawnBlock marks the spawn point as set by the block at the position passed.
+	TrackSpawnBlock(pos cube.Pos)
+}
+
+// LightEmissionLevel returns a light level that increases with the charge of the respawn anchor.
+func (r RespawnAnchor) LightEmissionLevel() uint8 {
+	return [5]uint8{0, 3, 7, 11, 15}[max(min(r.Charge, 4), 0)]
+}
+
+// BreakInfo ...
+func (r RespawnAnchor) BreakInfo() BreakInfo {
+	return newBreakInfo(50, func(t item.Tool) bool {
+		return t.ToolType() == item.TypePickaxe && t.HarvestLevel() >= item.ToolTierDiamond.HarvestLevel
+	}, pickaxeEffective, oneOf(RespawnAnchor{})).withBlastResistance(6000)
+}
+
+// Activate charges the respawn anchor if glowstone is used on it. If not, the respawn anchor sets the spawn point
+// of the user if it is charged, or explodes if it is used outside the Nether.
+func (r RespawnAnchor) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, ctx *item.UseContext) bool {
+	held, _ := u.HeldItems()
+	if _, ok := held.Item().(Glowstone); ok && r.Charge < 4 {
+		r.Charge++
+		w.SetBlock(pos, r, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.RespawnAnchorCharge{})
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	if r.Charge == 0 {
+		return false
+	}
+	if w.Dimension() != world.Nether {
+		w.SetBlock(pos, nil, nil)
+		ExplosionConfig{Size: 5, SpawnFire: true}.Explode(w, pos.Vec3Centre())
+		return true
+	}
+	if s, ok := u.(spawnSetter); ok && w.PlayerSpawn(s.UUID()) != pos {
+		// Only the spawn point set last is used, so a spawn point previously set using a bed is reset.
+		if overworld := w.PortalDestination(world.Nether); overworld != w {
+			overworld.SetPlayerSpawn(s.UUID(), overworld.Spawn())
+		}
+		w.SetPlayerSpawn(s.UUID(), pos)
+		if t, ok := s.(spawnBlockTracker); ok {
+			t.TrackSpawnBlock(pos)
+		}
+		w.PlaySound(pos.Vec3Centre(), sound.RespawnAnchorSetSpawn{})
+		s.SendTranslation("tile.respawn_anchor.respawnSet")
+	}
+	return true
+}
+
+// EncodeItem ...
+func (RespawnAnchor) EncodeItem() (name string, meta int16) {
+	return "minecraft:respawn_anchor", 0
+}
+
+// EncodeBlock ...
+func (r RespawnAnchor) EncodeBlock() (string, map[string]any) {
+	if r.Charge < 0 || r.Charge > 4 {
+		panic("invalid respawn anchor charge")
+	}
+	return "minecraft:respawn_anchor", map[string]any{"respawn_anchor_charge": int32(r.Charge)}
+}
+
+// allRespawnAnchors ...
+func allRespawnAnchors() (anchors []world.Block) {
+	for i := 0; i < 5; i++ {
+		anchors = append(anchors, RespawnAnchor{Charge: i})
+	}
+	return
+}
@@ -0,0 +1,159 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Sapling is a non-solid plant that grows into a tree over time. Saplings of spruce, jungle and dark oak wood may
+// be placed in a 2x2 square to grow a giant tree.
+type Sapling struct {
+	empty
+	transparent
+
+	// Wood is the type of wood of the tree that the sapling grows into. Saplings exist for all wood types except
+	// crimson, warped and mangrove wood.
+	Wood WoodType
+	// Ready specifies if the sapling is ready to grow into a tree. A sapling that is ready grows into a tree the
+	// next time it grows.
+	Ready bool
+}
+
+// RandomTick ...
+func (s Sapling) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	if w.Light(pos) >= 9 && r.Intn(7) == 0 {
+		s.advance(pos, w, r.Intn)
+	}
+}
+
+// BoneMeal ...
+func (s Sapling) BoneMeal(pos cube.Pos, w *world.World) bool {
+	if rand.Float64() < 0.45 {
+		s.advance(pos, w, rand.Intn)
+	}
+	return true
+}
+
+// advance advances the growth of the sapling: If it is not yet ready, it becomes ready. Otherwise, it attempts to
+// grow into a tree.
+func (s Sapling) advance(pos cube.Pos, w *world.World, intn func(n int) int) {
+	if !s.Ready {
+		s.Ready = true
+		w.SetBlock(pos, s, nil)
+		return
+	}
+	s.grow(pos, w, intn)
+}
+
+// grow attempts to grow the sapling into a tree. If the sapling is part of a 2x2 square of saplings of the same
+// wood type and a giant tree exists for its wood type, a giant tree is grown instead.
+func (s Sapling) grow(pos cube.Pos, w *world.World, intn func(n int) int) {
+	if corner, ok := s.giantCorner(pos, w); ok {
+		if t, ok := newTree(s.Wood, true, intn); ok && t.fits(corner, w) {
+			t.grow(corner, w)
+			return
+		}
+	}
+	if t, ok := newTree(s.Wood, false, intn); ok && t.fits(pos, w) {
+		t.grow(pos, w)
+	}
+}
+
+// giantCorner looks for a 2x2 square of saplings of the same wood type that the sapling at the position passed is
+// part of. If found, the north-west corner of the square is returned.
+func (s Sapling) giantCorner(pos cube.Pos, w *world.World) (cube.Pos, bool) {
+	switch s.Wood {
+	case SpruceWood(), JungleWood(), DarkOakWood():
+	default:
+		return cube.Pos{}, false
+	}
+	for _, off := range []cube.Pos{{0, 0, 0}, {-1, 0, 0}, {0, 0, -1}, {-1, 0, -1}} {
+		corner, found := pos.Add(off), true
+		for _, square := range []cube.Pos{{0, 0, 0}, {1, 0, 0}, {0, 0, 1}, {1, 0, 1}} {
+			if sapling, ok := w.Block(corner.Add(square)).(Sapling); !ok || sapling.Wood != s.Wood {
+				found = false
+				break
+			}
+		}
+		if found {
+			return corner, true
+		}
+	}
+	return cube.Pos{}, false
+}
+
+// NeighbourUpdateTick ...
+func (s Sapling) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !supportsVegetation(s, w.Block(pos.Side(cube.FaceDown))) {
+		w.SetBlock(pos, nil, nil)
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: s})
+		dropItem(w, item.NewStack(s, 1), pos.Vec3Centre())
+	}
+}
+
+// UseOnBlock ...
+func (s Sapling) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, s)
+	if !used {
+		return false
+	}
+	if !supportsVegetation(s, w.Block(pos.Side(cube.FaceDown))) {
+		return false
+	}
+
+	place(w, pos, Sapling{Wood: s.Wood}, user, ctx)
+	return placed(ctx)
+}
+
+// HasLiquidDrops ...
+func (Sapling) HasLiquidDrops() bool {
+	return true
+}
+
+// FlammabilityInfo ...
+func (Sapling) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(60, 100, false)
+}
+
+// BreakInfo ...
+func (s Sapling) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(Sapling{Wood: s.Wood}))
+}
+
+// FuelInfo ...
+func (Sapling) FuelInfo() item.FuelInfo {
+	return newFuelInfo(time.Second * 5)
+}
+
+// CompostChance ...
+func (Sapling) CompostChance() float64 {
+	return 0.3
+}
+
+// EncodeItem ...
+func (s Sapling) EncodeItem() (name string, meta int16) {
+	return "minecraft:" + s.Wood.String() + "_sapling", 0
+}
+
+// EncodeBlock ...
+func (s Sapling) EncodeBlock() (string, map[string]any) {
+	return "minecraft:" + s.Wood.String() + "_sapling", map[string]any{"age_bit": boolByte(s.Ready)}
+}
+
+// saplingWoodTypes returns all wood types that saplings exist for.
+func saplingWoodTypes() []WoodType {
+	return []WoodType{OakWood(), SpruceWood(), BirchWood(), JungleWood(), AcaciaWood(), DarkOakWood(), Cherry()}
+}
+
+// allSaplings ...
+func allSaplings() (saplings []world.Block) {
+	for _, w := range saplingWoodTypes() {
+		saplings = append(saplings, Sapling{Wood: w}, Sapling{Wood: w, Ready: true})
+	}
+	return
+}
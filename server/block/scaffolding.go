@@ -0,0 +1,166 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Scaffolding is a temporary structure block that entities may climb up and sneak down through. Scaffolding may
+// extend up to 6 blocks horizontally from the scaffolding supporting it, after which it falls.
+type Scaffolding struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Stability is the distance of the scaffolding from the closest scaffolding supported by a block below it,
+	// ranging from 0 to 7. Scaffolding with a stability of 7 is unsupported and falls.
+	Stability int
+}
+
+// maxScaffoldingStability is the stability at which scaffolding is no longer supported and falls.
+const maxScaffoldingStability = 7
+
+// Model ...
+func (Scaffolding) Model() world.BlockModel {
+	return model.Scaffolding{}
+}
+
+// SideClosed ...
+func (Scaffolding) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// EntityInside ...
+func (Scaffolding) EntityInside(_ cube.Pos, _ *world.World, e world.Entity) {
+	if f, ok := e.(fallDistanceEntity); ok {
+		// Entities climbing scaffolding do not take fall damage.
+		f.ResetFallDistance()
+	}
+}
+
+// stability calculates the stability of scaffolding at the position passed. Scaffolding on top of other scaffolding
+// has the same stability as the scaffolding below it, whereas scaffolding on top of a solid block has a stability of
+// 0. Otherwise, the stability is one higher than that of the most stable scaffolding next to it.
+func (Scaffolding) stability(pos cube.Pos, w *world.World) int {
+	below := pos.Side(cube.FaceDown)
+	if s, ok := w.Block(below).(Scaffolding); ok {
+		return s.Stability
+	}
+	if w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
+		return 0
+	}
+	stability := maxScaffoldingStability
+	for _, face := range cube.HorizontalFaces() {
+		if s, ok := w.Block(pos.Side(face)).(Scaffolding); ok {
+			stability = min(stability, s.Stability+1)
+		}
+	}
+	return stability
+}
+
+// NeighbourUpdateTick ...
+func (Scaffolding) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	w.ScheduleBlockUpdate(pos, time.Millisecond*50)
+}
+
+// ScheduledTick ...
+func (s Scaffolding) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	stability := s.stability(pos, w)
+	if stability >= maxScaffoldingStability {
+		w.SetBlock(pos, nil, nil)
+		if _, air := w.Block(pos.Side(cube.FaceDown)).Model().(model.Empty); air {
+			w.AddEntity(w.EntityRegistry().Config().FallingBlock(Scaffolding{Stability: maxScaffoldingStability}, pos.Vec3Centre()))
+			return
+		}
+		// Unsupported scaffolding that cannot fall any further breaks instead.
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: s})
+		dropItem(w, item.NewStack(Scaffolding{}, 1), pos.Vec3Centre())
+		return
+	}
+	if stability != s.Stability {
+		s.Stability = stability
+		w.SetBlock(pos, s, nil)
+	}
+}
+
+// Landed ...
+func (Scaffolding) Landed(w *world.World, pos cube.Pos) {
+	w.ScheduleBlockUpdate(pos, time.Millisecond*50)
+}
+
+// UseOnBlock ...
+func (s Scaffolding) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	if _, ok := w.Block(pos).(Scaffolding); ok {
+		// Scaffolding placed against other scaffolding extends it: Upwards if one of the sides was clicked, or
+		// horizontally in the direction the user is facing if the top was clicked.
+		dir, limit := cube.FaceUp, w.Range()[1]-pos.Y()
+		if face == cube.FaceUp {
+			dir, limit = user.Rotation().Direction().Face(), maxScaffoldingStability
+		}
+		extended := false
+		for i := 0; i < limit; i++ {
+			pos = pos.Side(dir)
+			if _, ok := w.Block(pos).(Scaffolding); ok {
+				continue
+			}
+			if !replaceableWith(w, pos, s) {
+				return false
+			}
+			extended = true
+			break
+		}
+		if !extended {
+			return false
+		}
+	} else {
+		var used bool
+		if pos, _, used = firstReplaceable(w, pos, face, s); !used {
+			return false
+		}
+	}
+	stability := s.stability(pos, w)
+	if stability >= maxScaffoldingStability {
+		return false
+	}
+
+	place(w, pos, Scaffolding{Stability: stability}, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (Scaffolding) BreakInfo() BreakInfo {
+	return newBreakInfo(0, alwaysHarvestable, nothingEffective, oneOf(Scaffolding{}))
+}
+
+// FlammabilityInfo ...
+func (Scaffolding) FlammabilityInfo() FlammabilityInfo {
+	return newFlammabilityInfo(60, 60, false)
+}
+
+// FuelInfo ...
+func (Scaffolding) FuelInfo() item.FuelInfo {
+	return newFuelInfo(time.Second * 5 / 2)
+}
+
+// EncodeItem ...
+func (Scaffolding) EncodeItem() (name string, meta int16) {
+	return "minecraft:scaffolding", 0
+}
+
+// EncodeBlock ...
+func (s Scaffolding) EncodeBlock() (string, map[string]any) {
+	return "minecraft:scaffolding", map[string]any{"stability": int32(s.Stability), "stability_check": uint8(0)}
+}
+
+// allScaffolding ...
+func allScaffolding() (scaffolding []world.Block) {
+	for stability := 0; stability <= maxScaffoldingStability; stability++ {
+		scaffolding = append(scaffolding, Scaffolding{Stability: stability})
+	}
+	return
+}
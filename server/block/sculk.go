@@ -0,0 +1,37 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Sculk is a block found in the deep dark. Sculk spreads from sculk catalysts when mobs die near them.
+type Sculk struct {
+	solid
+}
+
+// BreakInfo ...
+func (s Sculk) BreakInfo() BreakInfo {
+	return newBreakInfo(0.2, alwaysHarvestable, hoeEffective, silkTouchOnlyDrop(s)).withXPDropRange(1, 1)
+}
+
+// EncodeItem ...
+func (Sculk) EncodeItem() (name string, meta int16) {
+	return "minecraft:sculk", 0
+}
+
+// EncodeBlock ...
+func (Sculk) EncodeBlock() (string, map[string]any) {
+	return "minecraft:sculk", nil
+}
+
+// sculkReplaceable checks if the block passed may be replaced by sculk spreading from a sculk catalyst.
+func sculkReplaceable(b world.Block) bool {
+	switch b := b.(type) {
+	case Stone, Dirt, Grass, Podzol, Mud, Clay, Sand, Gravel, Netherrack, Andesite, Diorite, Granite,
+		Tuff, Calcite:
+		return true
+	case Deepslate:
+		return b.Type == NormalDeepslate()
+	}
+	return false
+}
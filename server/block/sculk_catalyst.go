@@ -0,0 +1,114 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"math/rand"
+	"time"
+)
+
+// SculkCatalyst is a block that blooms when an entity dies near it, spreading sculk over the blocks around the
+// position that the entity died at.
+type SculkCatalyst struct {
+	solid
+
+	// Bloom specifies if the sculk catalyst is currently blooming.
+	Bloom bool
+}
+
+// GameEventRange ...
+func (SculkCatalyst) GameEventRange() float64 {
+	return 8
+}
+
+// ReceiveGameEvent ...
+func (s SculkCatalyst) ReceiveGameEvent(pos cube.Pos, v world.Vibration, w *world.World) {
+	if v.Event != world.GameEventEntityDie() {
+		return
+	}
+	charge := 5
+	if e, ok := v.Source.(interface{ ExperienceLevel() int }); ok {
+		charge = max(charge, min(e.ExperienceLevel()*7, 100))
+	}
+	s.Bloom = true
+	w.SetBlock(pos, s, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.SculkCatalystBloom{})
+	w.ScheduleBlockUpdate(pos, time.Second*4)
+
+	spreadSculk(cube.PosFromVec3(v.Pos).Side(cube.FaceDown), charge, w)
+}
+
+// spreadSculk spreads sculk over blocks around the position passed. Every block that sculk spreads to costs one
+// charge. Sculk only spreads to blocks that are exposed to air from above.
+func spreadSculk(start cube.Pos, charge int, w *world.World) {
+	queue, visited := []cube.Pos{start}, map[cube.Pos]struct{}{start: {}}
+	for len(queue) > 0 && charge > 0 {
+		pos := queue[0]
+		queue = queue[1:]
+		if pos.Vec3().Sub(start.Vec3()).Len() > 8 {
+			continue
+		}
+		if sculkReplaceable(w.Block(pos)) {
+			if _, air := w.Block(pos.Side(cube.FaceUp)).(Air); air {
+				w.SetBlock(pos, Sculk{}, nil)
+				w.PlaySound(pos.Vec3Centre(), sound.SculkSpread{})
+				charge--
+			}
+		} else if _, ok := w.Block(pos).(Sculk); !ok && pos != start {
+			continue
+		}
+		neighbours := make([]cube.Pos, 0, 12)
+		for _, face := range cube.HorizontalFaces() {
+			side := pos.Side(face)
+			neighbours = append(neighbours, side, side.Side(cube.FaceUp), side.Side(cube.FaceDown))
+		}
+		rand.Shuffle(len(neighbours), func(i, j int) {
+			neighbours[i], neighbours[j] = neighbours[j], neighbours[i]
+		})
+		for _, n := range neighbours {
+			if _, ok := visited[n]; !ok {
+				visited[n] = struct{}{}
+				queue = append(queue, n)
+			}
+		}
+	}
+}
+
+// ScheduledTick ...
+func (s SculkCatalyst) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if s.Bloom {
+		s.Bloom = false
+		w.SetBlock(pos, s, nil)
+	}
+}
+
+// LightEmissionLevel ...
+func (SculkCatalyst) LightEmissionLevel() uint8 {
+	return 6
+}
+
+// BreakInfo ...
+func (s SculkCatalyst) BreakInfo() BreakInfo {
+	return newBreakInfo(3, alwaysHarvestable, hoeEffective, silkTouchOnlyDrop(SculkCatalyst{})).withXPDropRange(5, 5)
+}
+
+// EncodeItem ...
+func (SculkCatalyst) EncodeItem() (name string, meta int16) {
+	return "minecraft:sculk_catalyst", 0
+}
+
+// EncodeBlock ...
+func (s SculkCatalyst) EncodeBlock() (string, map[string]any) {
+	return "minecraft:sculk_catalyst", map[string]any{"bloom": boolByte(s.Bloom)}
+}
+
+// DecodeNBT ...
+func (s SculkCatalyst) DecodeNBT(map[string]any) any {
+	return s
+}
+
+// EncodeNBT ...
+func (s SculkCatalyst) EncodeNBT() map[string]any {
+	return map[string]any{"id": "SculkCatalyst"}
+}
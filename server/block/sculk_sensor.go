@@ -0,0 +1,136 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// SculkSensor is a block that detects vibrations caused by game events around it, such as footsteps or blocks
+// being placed. When it detects a vibration, it emits a redstone signal with a strength equal to the frequency of
+// the vibration.
+type SculkSensor struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Phase is the phase of the sculk sensor. A phase of 0 means the sensor is inactive and listening for
+	// vibrations, 1 means it is active and emitting a redstone signal and 2 means it is cooling down, during which
+	// it does not detect vibrations.
+	Phase int
+	// Frequency is the frequency of the last vibration detected by the sculk sensor, ranging from 1 to 15. While
+	// active, the sensor emits a redstone signal with this strength.
+	Frequency int
+}
+
+// GameEventRange ...
+func (SculkSensor) GameEventRange() float64 {
+	return 8
+}
+
+// ReceiveGameEvent ...
+func (s SculkSensor) ReceiveGameEvent(pos cube.Pos, v world.Vibration, w *world.World) {
+	if s.Phase != 0 || v.Event == world.GameEventSculkSensorActivate() || cube.PosFromVec3(v.Pos) == pos {
+		return
+	}
+	s.Phase, s.Frequency = 1, v.Event.Frequency()
+	w.SetBlock(pos, s, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.SculkSensorPowerOn{})
+	w.ScheduleBlockUpdate(pos, time.Second*2)
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventSculkSensorActivate(), v.Source)
+}
+
+// ScheduledTick ...
+func (s SculkSensor) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	switch s.Phase {
+	case 1:
+		s.Phase = 2
+		w.SetBlock(pos, s, nil)
+		w.PlaySound(pos.Vec3Centre(), sound.SculkSensorPowerOff{})
+		w.ScheduleBlockUpdate(pos, time.Millisecond*500)
+	case 2:
+		s.Phase = 0
+		w.SetBlock(pos, s, nil)
+	}
+}
+
+// WeakPower ...
+func (s SculkSensor) WeakPower(cube.Pos, cube.Face, *world.World) int {
+	if s.Phase == 1 {
+		return s.Frequency
+	}
+	return 0
+}
+
+// StrongPower ...
+func (s SculkSensor) StrongPower(_ cube.Pos, face cube.Face, _ *world.World) int {
+	if s.Phase == 1 && face == cube.FaceDown {
+		return s.Frequency
+	}
+	return 0
+}
+
+// LightEmissionLevel ...
+func (SculkSensor) LightEmissionLevel() uint8 {
+	return 1
+}
+
+// Model ...
+func (SculkSensor) Model() world.BlockModel {
+	return model.Slab{}
+}
+
+// SideClosed ...
+func (SculkSensor) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// UseOnBlock ...
+func (s SculkSensor) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, s)
+	if !used {
+		return false
+	}
+
+	place(w, pos, SculkSensor{}, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (s SculkSensor) BreakInfo() BreakInfo {
+	return newBreakInfo(1.5, alwaysHarvestable, hoeEffective, silkTouchOnlyDrop(SculkSensor{})).withXPDropRange(5, 5)
+}
+
+// EncodeItem ...
+func (SculkSensor) EncodeItem() (name string, meta int16) {
+	return "minecraft:sculk_sensor", 0
+}
+
+// EncodeBlock ...
+func (s SculkSensor) EncodeBlock() (string, map[string]any) {
+	return "minecraft:sculk_sensor", map[string]any{"sculk_sensor_phase": int32(s.Phase)}
+}
+
+// DecodeNBT ...
+func (s SculkSensor) DecodeNBT(data map[string]any) any {
+	s.Frequency = int(nbtconv.Int32(data, "last_vibration_frequency"))
+	return s
+}
+
+// EncodeNBT ...
+func (s SculkSensor) EncodeNBT() map[string]any {
+	return map[string]any{"id": "SculkSensor", "last_vibration_frequency": int32(s.Frequency)}
+}
+
+// allSculkSensors ...
+func allSculkSensors() (sensors []world.Block) {
+	for phase := 0; phase <= 2; phase++ {
+		sensors = append(sensors, SculkSensor{Phase: phase})
+	}
+	return
+}
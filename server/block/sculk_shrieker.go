@@ -0,0 +1,134 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/effect"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// SculkShrieker is a block that shrieks when a player activates a sculk sensor near it or steps on it. Sculk
+// shriekers that can summon additionally inflict darkness on players nearby.
+type SculkShrieker struct {
+	transparent
+	sourceWaterDisplacer
+
+	// Active specifies if the sculk shrieker is currently shrieking.
+	Active bool
+	// CanSummon specifies if the sculk shrieker inflicts darkness on nearby players when shrieking. Sculk
+	// shriekers placed by players cannot summon.
+	CanSummon bool
+}
+
+// GameEventRange ...
+func (SculkShrieker) GameEventRange() float64 {
+	return 8
+}
+
+// ReceiveGameEvent ...
+func (s SculkShrieker) ReceiveGameEvent(pos cube.Pos, v world.Vibration, w *world.World) {
+	if s.Active {
+		return
+	}
+	switch v.Event {
+	case world.GameEventSculkSensorActivate():
+	case world.GameEventStep():
+		if stepPos := cube.PosFromVec3(v.Pos); stepPos != pos && stepPos.Side(cube.FaceDown) != pos {
+			return
+		}
+	default:
+		return
+	}
+	if p, ok := v.Source.(interface{ GameMode() world.GameMode }); !ok || !p.GameMode().AllowsTakingDamage() {
+		// Only players that may take damage trigger sculk shriekers.
+		return
+	}
+	s.Active = true
+	w.SetBlock(pos, s, nil)
+	w.PlaySound(pos.Vec3Centre(), sound.SculkShriek{})
+	w.ScheduleBlockUpdate(pos, time.Millisecond*4500)
+
+	if !s.CanSummon {
+		return
+	}
+	for _, e := range w.EntitiesWithin(cube.Box(-40, -40, -40, 40, 40, 40).Translate(pos.Vec3Centre()), nil) {
+		if p, ok := e.(darknessAffected); ok && p.GameMode().AllowsTakingDamage() {
+			p.AddEffect(effect.New(effect.Darkness{}, 1, time.Second*12))
+		}
+	}
+}
+
+// darknessAffected represents an entity that may be inflicted with darkness by a sculk shrieker.
+type darknessAffected interface {
+	// AddEffect adds a specific effect to the entity that implements this interface.
+	AddEffect(e effect.Effect)
+	// GameMode returns the game mode of the entity.
+	GameMode() world.GameMode
+}
+
+// ScheduledTick ...
+func (s SculkShrieker) ScheduledTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if s.Active {
+		s.Active = false
+		w.SetBlock(pos, s, nil)
+	}
+}
+
+// Model ...
+func (SculkShrieker) Model() world.BlockModel {
+	return model.Slab{}
+}
+
+// SideClosed ...
+func (SculkShrieker) SideClosed(cube.Pos, cube.Pos, *world.World) bool {
+	return false
+}
+
+// UseOnBlock ...
+func (s SculkShrieker) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, s)
+	if !used {
+		return false
+	}
+
+	place(w, pos, SculkShrieker{}, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (s SculkShrieker) BreakInfo() BreakInfo {
+	return newBreakInfo(3, alwaysHarvestable, hoeEffective, silkTouchOnlyDrop(SculkShrieker{})).withXPDropRange(5, 5)
+}
+
+// EncodeItem ...
+func (SculkShrieker) EncodeItem() (name string, meta int16) {
+	return "minecraft:sculk_shrieker", 0
+}
+
+// EncodeBlock ...
+func (s SculkShrieker) EncodeBlock() (string, map[string]any) {
+	return "minecraft:sculk_shrieker", map[string]any{"active": boolByte(s.Active), "can_summon": boolByte(s.CanSummon)}
+}
+
+// DecodeNBT ...
+func (s SculkShrieker) DecodeNBT(map[string]any) any {
+	return s
+}
+
+// EncodeNBT ...
+func (s SculkShrieker) EncodeNBT() map[string]any {
+	return map[string]any{"id": "SculkShrieker"}
+}
+
+// allSculkShriekers ...
+func allSculkShriekers() (shriekers []world.Block) {
+	for _, active := range []bool{false, true} {
+		shriekers = append(shriekers, SculkShrieker{Active: active}, SculkShrieker{Active: active, CanSummon: true})
+	}
+	return
+}
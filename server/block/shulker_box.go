@@ -0,0 +1,225 @@
+package block
+
+import (
+	"fmt"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/event"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"strings"
+	"sync"
+)
+
+// ShulkerBox is a container block that keeps its contents when it is broken. The shulker box, as an item, holds
+// the items that were stored in it, so that they are put back in the shulker box when it is placed again.
+// The empty value of ShulkerBox is not valid as a block. It must be created using block.NewShulkerBox().
+type ShulkerBox struct {
+	solid
+
+	// Type is the type of the shulker box, which specifies its colour.
+	Type ShulkerBoxType
+	// Facing is the face the shulker box opens towards.
+	Facing cube.Face
+	// CustomName is the custom name of the shulker box. This name is displayed when the shulker box is opened,
+	// and may include colour codes.
+	CustomName string
+
+	inventory *inventory.Inventory
+	viewerMu  *sync.RWMutex
+	viewers   map[ContainerViewer]struct{}
+}
+
+// NewShulkerBox creates a new initialised shulker box. The inventory is properly initialised.
+func NewShulkerBox() ShulkerBox {
+	m := new(sync.RWMutex)
+	v := make(map[ContainerViewer]struct{}, 1)
+	inv := inventory.New(27, func(slot int, _, item item.Stack) {
+		m.RLock()
+		defer m.RUnlock()
+		for viewer := range v {
+			viewer.ViewSlotChange(slot, item)
+		}
+	})
+	inv.Handle(shulkerBoxHandler{})
+	return ShulkerBox{
+		Facing:    cube.FaceUp,
+		inventory: inv,
+		viewerMu:  m,
+		viewers:   v,
+	}
+}
+
+// shulkerBoxHandler is the inventory.Handler of the inventory of a shulker box. It prevents shulker boxes from being
+// put inside other shulker boxes.
+type shulkerBoxHandler struct {
+	inventory.NopHandler
+}
+
+// HandlePlace ...
+func (shulkerBoxHandler) HandlePlace(ctx *event.Context, _ int, it item.Stack) {
+	if _, ok := it.Item().(ShulkerBox); ok {
+		ctx.Cancel()
+	}
+}
+
+// Inventory returns the inventory of the shulker box. The size of the inventory will be 27.
+func (s ShulkerBox) Inventory() *inventory.Inventory {
+	return s.inventory
+}
+
+// WithName returns the shulker box after applying a specific name to the block.
+func (s ShulkerBox) WithName(a ...any) world.Item {
+	s.CustomName = strings.TrimSuffix(fmt.Sprintln(a...), "\n")
+	return s
+}
+
+// MaxCount always returns 1.
+func (ShulkerBox) MaxCount() int {
+	return 1
+}
+
+// open opens the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) open(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, OpenAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxOpen{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerOpen(), nil)
+}
+
+// close closes the shulker box, displaying the animation and playing a sound.
+func (s ShulkerBox) close(w *world.World, pos cube.Pos) {
+	for _, v := range w.Viewers(pos.Vec3()) {
+		v.ViewBlockAction(pos, CloseAction{})
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.ShulkerBoxClose{})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventContainerClose(), nil)
+}
+
+// AddViewer adds a viewer to the shulker box, so that it is updated whenever the inventory of the shulker box is
+// changed.
+func (s ShulkerBox) AddViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		s.open(w, pos)
+	}
+	s.viewers[v] = struct{}{}
+}
+
+// RemoveViewer removes a viewer from the shulker box, so that slot updates in the inventory are no longer sent to
+// it.
+func (s ShulkerBox) RemoveViewer(v ContainerViewer, w *world.World, pos cube.Pos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	if len(s.viewers) == 0 {
+		return
+	}
+	delete(s.viewers, v)
+	if len(s.viewers) == 0 {
+		s.close(w, pos)
+	}
+}
+
+// Activate ...
+func (s ShulkerBox) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
+	if opener, ok := u.(ContainerOpener); ok {
+		if !s.obstructed(pos, w) {
+			opener.OpenBlockContainer(pos)
+		}
+		return true
+	}
+	return false
+}
+
+// obstructed checks if the lid of the shulker box is obstructed by the block in front of it, preventing the shulker
+// box from being opened.
+func (s ShulkerBox) obstructed(pos cube.Pos, w *world.World) bool {
+	side := pos.Side(s.Facing)
+	// The lid of the shulker box moves half a block outwards when opened.
+	lid := cube.Box(0, 0, 0, 1, 1, 1).TranslateTowards(s.Facing.Opposite(), 0.5)
+	for _, box := range w.Block(side).Model().BBox(side, w) {
+		if box.IntersectsWith(lid) {
+			return true
+		}
+	}
+	return false
+}
+
+// UseOnBlock ...
+func (s ShulkerBox) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, s)
+	if !used {
+		return
+	}
+	box := NewShulkerBox()
+	box.Type, box.Facing, box.CustomName = s.Type, face, s.CustomName
+	if s.inventory != nil {
+		for slot, it := range s.inventory.Slots() {
+			_ = box.inventory.SetItem(slot, it)
+		}
+	}
+
+	place(w, pos, box, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (s ShulkerBox) BreakInfo() BreakInfo {
+	return newBreakInfo(2, alwaysHarvestable, pickaxeEffective, oneOf(s))
+}
+
+// EncodeItem ...
+func (s ShulkerBox) EncodeItem() (name string, meta int16) {
+	return "minecraft:" + s.Type.String() + "_shulker_box", 0
+}
+
+// EncodeBlock ...
+func (s ShulkerBox) EncodeBlock() (string, map[string]any) {
+	return "minecraft:" + s.Type.String() + "_shulker_box", nil
+}
+
+// DecodeNBT ...
+func (s ShulkerBox) DecodeNBT(data map[string]any) any {
+	t := s.Type
+	//noinspection GoAssignmentToReceiver
+	s = NewShulkerBox()
+	s.Type = t
+	if _, ok := data["facing"]; ok {
+		s.Facing = cube.Face(nbtconv.Uint8(data, "facing"))
+	}
+	s.CustomName = nbtconv.String(data, "CustomName")
+	nbtconv.InvFromNBT(s.inventory, nbtconv.Slice(data, "Items"))
+	return s
+}
+
+// EncodeNBT ...
+func (s ShulkerBox) EncodeNBT() map[string]any {
+	if s.inventory == nil {
+		t, facing, customName := s.Type, s.Facing, s.CustomName
+		//noinspection GoAssignmentToReceiver
+		s = NewShulkerBox()
+		s.Type, s.Facing, s.CustomName = t, facing, customName
+	}
+	m := map[string]any{
+		"Items":  nbtconv.InvToNBT(s.inventory),
+		"id":     "ShulkerBox",
+		"facing": uint8(s.Facing),
+	}
+	if s.CustomName != "" {
+		m["CustomName"] = s.CustomName
+	}
+	return m
+}
+
+// allShulkerBoxes ...
+func allShulkerBoxes() (boxes []world.Block) {
+	for _, t := range ShulkerBoxTypes() {
+		boxes = append(boxes, ShulkerBox{Type: t})
+	}
+	return
+}
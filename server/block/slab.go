@@ -7,6 +7,7 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
 	"time"
 )
 
@@ -103,13 +104,39 @@ func (s Slab) LightDiffusionLevel() uint8 {
 	return 0
 }
 
+// RandomTicks returns true if the slab is made of copper that can still oxidise.
+func (s Slab) RandomTicks() bool {
+	return oxidisable(s)
+}
+
+// RandomTick ...
+func (s Slab) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	oxidise(pos, w, r, s)
+}
+
+// Wax ...
+func (s Slab) Wax(cube.Pos, mgl64.Vec3) (world.Block, bool) {
+	return waxCopper(s)
+}
+
+// Scrape ...
+func (s Slab) Scrape() (world.Block, bool, bool) {
+	return scrapeCopper(s)
+}
+
+// Deoxidise ...
+func (s Slab) Deoxidise() (world.Block, bool) {
+	return deoxidiseCopper(s)
+}
+
 // BreakInfo ...
 func (s Slab) BreakInfo() BreakInfo {
 	hardness, blastResistance, harvestable, effective := 2.0, 30.0, pickaxeHarvestable, pickaxeEffective
 
 	switch block := s.Block.(type) {
-	// TODO: Copper
 	// TODO: Deepslate
+	case Copper:
+		hardness, blastResistance, harvestable = 3, 6, copperHarvestable
 	case EndBricks:
 		hardness = 3.0
 		blastResistance = 45.0
@@ -172,6 +199,9 @@ func (s Slab) EncodeBlock() (string, map[string]any) {
 		if s.Double {
 			id = "double_" + id
 		}
+	} else if c, ok := s.Block.(Copper); ok && s.Double {
+		// Double copper slabs have the double_ prefix in the middle of their name, after the wax and oxidation.
+		id = c.prefix() + "double_cut_copper_slab"
 	} else if s.Double {
 		id = id + "_double_slab"
 	} else {
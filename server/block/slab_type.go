@@ -7,7 +7,6 @@ import (
 // encodeSlabBlock encodes the provided block in to an identifier and meta value that can be used to encode the slab.
 func encodeSlabBlock(block world.Block) (id, slabType string, meta int16) {
 	switch block := block.(type) {
-	// TODO: Copper
 	case Andesite:
 		if block.Polished {
 			return "polished_andesite", "stone_slab_type_3", 2
@@ -26,6 +25,10 @@ func encodeSlabBlock(block world.Block) (id, slabType string, meta int16) {
 			return "mossy_cobblestone", "stone_slab_type_2", 5
 		}
 		return "cobblestone", "stone_slab_type", 3
+	case Copper:
+		if block.Type == CutCopper() {
+			return block.prefix() + "cut_copper", "", 0
+		}
 	case Deepslate:
 		if block.Type == CobbledDeepslate() {
 			return "cobbled_deepslate", "", 0
@@ -135,7 +138,6 @@ func encodeLegacySlabId(slabType string) string {
 // SlabBlocks returns a list of all possible blocks for a slab.
 func SlabBlocks() []world.Block {
 	b := []world.Block{
-		// TODO: Copper
 		Andesite{Polished: true},
 		Andesite{},
 		Blackstone{Type: PolishedBlackstone()},
@@ -164,6 +166,7 @@ func SlabBlocks() []world.Block {
 		Stone{Smooth: true},
 		Stone{},
 	}
+	b = append(b, copperBlocks(CutCopper())...)
 	for _, p := range PrismarineTypes() {
 		b = append(b, Prismarine{Type: p})
 	}
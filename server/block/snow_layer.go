@@ -0,0 +1,99 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/model"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// SnowLayer is a thin layer of snow that forms on top of blocks when it snows in cold biomes. Up to eight layers of
+// snow may be stacked in a single block. Snow layers melt near bright light sources.
+type SnowLayer struct {
+	transparent
+
+	// Height is the height of the snow layer, ranging from 0 to 7. A height of 0 represents a single layer of snow,
+	// whereas a height of 7 represents eight layers, which is a full block.
+	Height int
+}
+
+// Model ...
+func (s SnowLayer) Model() world.BlockModel {
+	return model.SnowLayer{Height: s.Height}
+}
+
+// ReplaceableBy ...
+func (s SnowLayer) ReplaceableBy(b world.Block) bool {
+	if _, ok := b.(SnowLayer); ok {
+		return false
+	}
+	return s.Height == 0
+}
+
+// RandomTick ...
+func (s SnowLayer) RandomTick(pos cube.Pos, w *world.World, _ *rand.Rand) {
+	if w.BlockLight(pos) > 11 {
+		w.SetBlock(pos, nil, nil)
+	}
+}
+
+// NeighbourUpdateTick ...
+func (s SnowLayer) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	if !s.supported(pos, w) {
+		w.SetBlock(pos, nil, nil)
+	}
+}
+
+// supported checks if the snow layer at the position passed is supported by the block below it.
+func (SnowLayer) supported(pos cube.Pos, w *world.World) bool {
+	below := pos.Side(cube.FaceDown)
+	return w.Block(below).Model().FaceSolid(below, cube.FaceUp, w)
+}
+
+// UseOnBlock ...
+func (s SnowLayer) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	if existing, ok := w.Block(pos).(SnowLayer); ok && existing.Height < 7 {
+		// Snow layers placed on top of other snow layers stack onto them.
+		existing.Height++
+		place(w, pos, existing, user, ctx)
+		return placed(ctx)
+	}
+	pos, _, used := firstReplaceable(w, pos, face, s)
+	if !used {
+		return false
+	}
+	if !s.supported(pos, w) {
+		return false
+	}
+
+	place(w, pos, SnowLayer{}, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (s SnowLayer) BreakInfo() BreakInfo {
+	harvestable := func(t item.Tool) bool {
+		return t.ToolType() == item.TypeShovel
+	}
+	return newBreakInfo(0.1, harvestable, shovelEffective, silkTouchDrop(item.NewStack(item.Snowball{}, s.Height+1), item.NewStack(SnowLayer{}, s.Height+1)))
+}
+
+// EncodeItem ...
+func (SnowLayer) EncodeItem() (name string, meta int16) {
+	return "minecraft:snow_layer", 0
+}
+
+// EncodeBlock ...
+func (s SnowLayer) EncodeBlock() (string, map[string]any) {
+	return "minecraft:snow_layer", map[string]any{"height": int32(s.Height), "covered_bit": uint8(0)}
+}
+
+// allSnowLayers ...
+func allSnowLayers() (layers []world.Block) {
+	for height := 0; height <= 7; height++ {
+		layers = append(layers, SnowLayer{Height: height})
+	}
+	return
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
 	"time"
 )
 
@@ -45,14 +46,40 @@ func (s Stairs) Model() world.BlockModel {
 	return model.Stair{Facing: s.Facing, UpsideDown: s.UpsideDown}
 }
 
+// RandomTicks returns true if the stairs are made of copper that can still oxidise.
+func (s Stairs) RandomTicks() bool {
+	return oxidisable(s)
+}
+
+// RandomTick ...
+func (s Stairs) RandomTick(pos cube.Pos, w *world.World, r *rand.Rand) {
+	oxidise(pos, w, r, s)
+}
+
+// Wax ...
+func (s Stairs) Wax(cube.Pos, mgl64.Vec3) (world.Block, bool) {
+	return waxCopper(s)
+}
+
+// Scrape ...
+func (s Stairs) Scrape() (world.Block, bool, bool) {
+	return scrapeCopper(s)
+}
+
+// Deoxidise ...
+func (s Stairs) Deoxidise() (world.Block, bool) {
+	return deoxidiseCopper(s)
+}
+
 // BreakInfo ...
 func (s Stairs) BreakInfo() BreakInfo {
 	hardness, blastResistance, harvestable, effective := 2.0, 30.0, pickaxeHarvestable, pickaxeEffective
 
 	switch block := s.Block.(type) {
-	// TODO: Copper
 	// TODO: Blackstone
 	// TODO: Deepslate
+	case Copper:
+		hardness, blastResistance, harvestable = 3, 6, copperHarvestable
 	case Planks:
 		harvestable = alwaysHarvestable
 		effective = axeEffective
@@ -7,7 +7,6 @@ import (
 // encodeStairsBlock encodes the provided block in to an identifier and meta value that can be used to encode the stairs.
 func encodeStairsBlock(block world.Block) string {
 	switch block := block.(type) {
-	// TODO: Copper
 	case Andesite:
 		if block.Polished {
 			return "polished_andesite"
@@ -26,6 +25,10 @@ func encodeStairsBlock(block world.Block) string {
 			return "mossy_cobblestone"
 		}
 		return "stone"
+	case Copper:
+		if block.Type == CutCopper() {
+			return block.prefix() + "cut_copper"
+		}
 	case Deepslate:
 		if block.Type == CobbledDeepslate() {
 			return "cobbled_deepslate"
@@ -112,7 +115,6 @@ func encodeStairsBlock(block world.Block) string {
 // StairsBlocks returns a list of all possible blocks for stairs.
 func StairsBlocks() []world.Block {
 	b := []world.Block{
-		// TODO: Copper
 		Andesite{Polished: true},
 		Andesite{},
 		Blackstone{Type: PolishedBlackstone()},
@@ -140,6 +142,7 @@ func StairsBlocks() []world.Block {
 		StoneBricks{},
 		Stone{},
 	}
+	b = append(b, copperBlocks(CutCopper())...)
 	for _, p := range PrismarineTypes() {
 		b = append(b, Prismarine{Type: p})
 	}
@@ -41,9 +41,22 @@ func (t TNT) Igniter() world.Entity {
 	return t.igniter
 }
 
-// Explode ...
+// NeighbourUpdateTick ...
+func (t TNT) NeighbourUpdateTick(pos, _ cube.Pos, w *world.World) {
+	t.RedstoneUpdate(pos, w)
+}
+
+// RedstoneUpdate ignites the TNT if it receives any redstone power.
+func (t TNT) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if w.RedstonePower(pos) > 0 {
+		t.Ignite(pos, w, nil)
+	}
+}
+
+// Explode primes the TNT with a short, randomised fuse of half a second up to one and a half seconds, so that
+// chained TNT does not all explode at the same time.
 func (t TNT) Explode(_ mgl64.Vec3, pos cube.Pos, w *world.World, _ ExplosionConfig) {
-	spawnTnt(pos, w, time.Second/2+time.Duration(rand.Intn(int(time.Second+time.Second/2))), t.igniter)
+	spawnTnt(pos, w, time.Second/2+time.Duration(rand.Int63n(int64(time.Second))), t.igniter)
 }
 
 // BreakInfo ...
@@ -0,0 +1,311 @@
+package block
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// tree is a world.Structure of a tree grown from a sapling. The blocks of the tree are positioned relative to the
+// base of its trunk, which, for giant trees with a 2x2 trunk, is the north-west block of the trunk.
+type tree struct {
+	wood  WoodType
+	giant bool
+
+	min, max cube.Pos
+	blocks   map[cube.Pos]world.Block
+	trunk    []cube.Pos
+}
+
+// newTree generates a new tree of the wood type passed. If giant is true, a tree with a 2x2 trunk is generated. Not
+// all wood types have giant variants: False is returned if no tree could be generated. The shape of the tree is
+// randomised using intn, which returns a random number in the range [0, n).
+func newTree(wood WoodType, giant bool, intn func(n int) int) (*tree, bool) {
+	t := &tree{wood: wood, giant: giant, blocks: make(map[cube.Pos]world.Block)}
+	switch wood {
+	case OakWood():
+		if giant {
+			return nil, false
+		}
+		t.blobTree(4+intn(3), intn)
+	case BirchWood():
+		if giant {
+			return nil, false
+		}
+		t.blobTree(5+intn(3), intn)
+	case JungleWood():
+		if giant {
+			t.blobTree(10+intn(20), intn)
+			break
+		}
+		t.blobTree(4+intn(7), intn)
+	case SpruceWood():
+		if giant {
+			t.spruceTree(13+intn(15), 3+intn(2), intn)
+			break
+		}
+		t.spruceTree(6+intn(4), 2+intn(2), intn)
+	case AcaciaWood():
+		if giant {
+			return nil, false
+		}
+		t.acaciaTree(5+intn(3), intn)
+	case DarkOakWood():
+		if !giant {
+			return nil, false
+		}
+		t.darkOakTree(6+intn(3), intn)
+	case Mangrove():
+		if giant {
+			return nil, false
+		}
+		t.mangroveTree(5+intn(4), intn)
+	case Cherry():
+		if giant {
+			return nil, false
+		}
+		t.cherryTree(4+intn(3), intn)
+	default:
+		return nil, false
+	}
+	return t, true
+}
+
+// blobTree generates a tree with a straight trunk and a round blob of leaves at the top, such as oak, birch and
+// jungle trees.
+func (t *tree) blobTree(height int, intn func(n int) int) {
+	t.straightTrunk(height)
+	radius := 2
+	if t.giant {
+		radius = 3
+	}
+	for y := height - 3; y <= height; y++ {
+		layerRadius := radius
+		if y >= height-1 {
+			layerRadius = radius - 1
+		}
+		t.leafLayer(cube.Pos{0, y, 0}, layerRadius, func(dx, dz int) bool {
+			// The corners of the top layer are always left out, while those of the other layers are left out
+			// randomly.
+			return abs(dx) == layerRadius && abs(dz) == layerRadius && (y == height || intn(2) == 0)
+		})
+	}
+}
+
+// spruceTree generates a tree with a straight trunk and cone shaped layers of leaves around it.
+func (t *tree) spruceTree(height, maxRadius int, intn func(n int) int) {
+	t.straightTrunk(height)
+	t.leaves(cube.Pos{0, height, 0})
+	if t.giant {
+		t.leafLayer(cube.Pos{0, height, 0}, 0, nil)
+	}
+	bottom := 1 + intn(2)
+	if t.giant {
+		bottom = height / 3
+	}
+	radius := 0
+	for y := height - 1; y >= bottom; y-- {
+		layerRadius := radius
+		t.leafLayer(cube.Pos{0, y, 0}, layerRadius, func(dx, dz int) bool {
+			return layerRadius > 0 && abs(dx) == layerRadius && abs(dz) == layerRadius
+		})
+		if radius >= maxRadius {
+			radius = 1
+		} else {
+			radius++
+		}
+	}
+}
+
+// acaciaTree generates a tree with a trunk that bends into one direction and a flat canopy of leaves.
+func (t *tree) acaciaTree(height int, intn func(n int) int) {
+	dir := cube.Directions()[intn(4)]
+	bend := height - 1 - intn(3)
+
+	t.set(cube.Pos{0, -1, 0}, Dirt{})
+	pos := cube.Pos{}
+	for y := 0; y < height; y++ {
+		if y >= bend {
+			pos = pos.Side(dir.Face())
+		}
+		pos[1] = y
+		t.log(pos)
+	}
+	t.leafLayer(pos, 3, func(dx, dz int) bool {
+		return abs(dx)+abs(dz) > 4
+	})
+	t.leafLayer(pos.Side(cube.FaceUp), 1, nil)
+}
+
+// darkOakTree generates a tree with a 2x2 trunk and a wide, flat canopy of leaves.
+func (t *tree) darkOakTree(height int, intn func(n int) int) {
+	t.straightTrunk(height)
+	for y := height - 2; y <= height; y++ {
+		radius := 3
+		if y == height {
+			radius = 2
+		}
+		t.leafLayer(cube.Pos{0, y, 0}, radius, func(dx, dz int) bool {
+			return abs(dx) == radius && abs(dz) == radius && (y == height || intn(2) == 0)
+		})
+	}
+}
+
+// mangroveTree generates a tree with a straight trunk and a wide blob of leaves.
+func (t *tree) mangroveTree(height int, intn func(n int) int) {
+	t.straightTrunk(height)
+	for y := height - 3; y <= height; y++ {
+		radius := 3
+		if y == height {
+			radius = 1
+		} else if y == height-1 {
+			radius = 2
+		}
+		t.leafLayer(cube.Pos{0, y, 0}, radius, func(dx, dz int) bool {
+			return abs(dx) == radius && abs(dz) == radius && (radius > 1 || intn(2) == 0)
+		})
+	}
+}
+
+// cherryTree generates a tree with a straight trunk and a wide, flat canopy of leaves.
+func (t *tree) cherryTree(height int, intn func(n int) int) {
+	t.straightTrunk(height)
+	for y := height - 2; y <= height; y++ {
+		radius := 4
+		if y == height {
+			radius = 2
+		} else if y == height-2 {
+			radius = 3
+		}
+		t.leafLayer(cube.Pos{0, y, 0}, radius, func(dx, dz int) bool {
+			return dx*dx+dz*dz > radius*radius || (dx*dx+dz*dz == radius*radius && intn(2) == 0)
+		})
+	}
+}
+
+// straightTrunk places a straight trunk of logs with the height passed. Grass and farmland below the trunk is turned
+// into dirt.
+func (t *tree) straightTrunk(height int) {
+	for _, base := range t.base() {
+		for y := 0; y < height; y++ {
+			t.log(base.Add(cube.Pos{0, y, 0}))
+		}
+		t.set(base.Side(cube.FaceDown), Dirt{})
+	}
+}
+
+// base returns the positions of the trunk of the tree at its base.
+func (t *tree) base() []cube.Pos {
+	if t.giant {
+		return []cube.Pos{{0, 0, 0}, {1, 0, 0}, {0, 0, 1}, {1, 0, 1}}
+	}
+	return []cube.Pos{{0, 0, 0}}
+}
+
+// leafLayer places a square layer of leaves around the centre passed with the radius passed. For giant trees, the
+// layer is centred around the 2x2 trunk. If skip is non-nil and returns true for a position, no leaves are placed
+// there.
+func (t *tree) leafLayer(centre cube.Pos, radius int, skip func(dx, dz int) bool) {
+	extra := 0
+	if t.giant {
+		extra = 1
+	}
+	for x := -radius; x <= radius+extra; x++ {
+		for z := -radius; z <= radius+extra; z++ {
+			// Offsets on the east and south side of a giant tree are measured from the second column of the trunk.
+			dx, dz := x, z
+			if dx > 0 {
+				dx -= extra
+			}
+			if dz > 0 {
+				dz -= extra
+			}
+			if skip != nil && skip(dx, dz) {
+				continue
+			}
+			t.leaves(centre.Add(cube.Pos{x, 0, z}))
+		}
+	}
+}
+
+// log places a log of the tree at the position passed.
+func (t *tree) log(pos cube.Pos) {
+	t.trunk = append(t.trunk, pos)
+	t.set(pos, Log{Wood: t.wood})
+}
+
+// leaves places leaves of the tree at the position passed, unless a log was already placed there.
+func (t *tree) leaves(pos cube.Pos) {
+	if _, ok := t.blocks[pos].(Log); !ok {
+		t.set(pos, Leaves{Wood: t.wood})
+	}
+}
+
+// set sets the block at a position relative to the base of the trunk of the tree.
+func (t *tree) set(pos cube.Pos, b world.Block) {
+	if len(t.blocks) == 0 {
+		t.min, t.max = pos, pos
+	}
+	for i := range pos {
+		t.min[i], t.max[i] = min(t.min[i], pos[i]), max(t.max[i], pos[i])
+	}
+	t.blocks[pos] = b
+}
+
+// fits checks if the trunk of the tree fits in the world when grown at the position passed.
+func (t *tree) fits(pos cube.Pos, w *world.World) bool {
+	if pos.Add(t.min).OutOfBounds(w.Range()) || pos.Add(t.max).OutOfBounds(w.Range()) {
+		return false
+	}
+	for _, trunk := range t.trunk {
+		if !treeReplaceable(w.Block(pos.Add(trunk))) {
+			return false
+		}
+	}
+	return true
+}
+
+// grow builds the tree in the world at the position passed.
+func (t *tree) grow(pos cube.Pos, w *world.World) {
+	w.BuildStructure(pos.Add(t.min), t)
+}
+
+// Dimensions ...
+func (t *tree) Dimensions() [3]int {
+	return [3]int{t.max[0] - t.min[0] + 1, t.max[1] - t.min[1] + 1, t.max[2] - t.min[2] + 1}
+}
+
+// At ...
+func (t *tree) At(x, y, z int, blockAt func(x, y, z int) world.Block) (world.Block, world.Liquid) {
+	b, ok := t.blocks[cube.Pos{x, y, z}.Add(t.min)]
+	if !ok {
+		return nil, nil
+	}
+	existing := blockAt(x, y, z)
+	switch b.(type) {
+	case Dirt:
+		switch existing.(type) {
+		case Grass, Farmland:
+			return b, nil
+		}
+		return nil, nil
+	case Leaves:
+		if _, ok := existing.(Leaves); ok || treeReplaceable(existing) {
+			return b, nil
+		}
+		return nil, nil
+	}
+	if treeReplaceable(existing) {
+		return b, nil
+	}
+	return nil, nil
+}
+
+// treeReplaceable checks if the block passed may be replaced by a growing tree.
+func treeReplaceable(b world.Block) bool {
+	switch b.(type) {
+	case Air, Leaves, Sapling, MangrovePropagule:
+		return true
+	}
+	r, ok := b.(Replaceable)
+	return ok && r.ReplaceableBy(Log{})
+}
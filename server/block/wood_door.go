@@ -6,7 +6,6 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
-	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
 	"time"
 )
@@ -97,35 +96,40 @@ func (d WoodDoor) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *worl
 		}
 	}
 
+	d.Open = w.RedstonePower(pos) > 0 || w.RedstonePower(pos.Side(cube.FaceUp)) > 0
+
 	ctx.IgnoreBBox = true
 	place(w, pos, d, user, ctx)
-	place(w, pos.Side(cube.FaceUp), WoodDoor{Wood: d.Wood, Facing: d.Facing, Top: true, Right: d.Right}, user, ctx)
+	place(w, pos.Side(cube.FaceUp), WoodDoor{Wood: d.Wood, Facing: d.Facing, Open: d.Open, Top: true, Right: d.Right}, user, ctx)
 	ctx.SubtractFromCount(1)
 	return placed(ctx)
 }
 
 // Activate ...
 func (d WoodDoor) Activate(pos cube.Pos, _ cube.Face, w *world.World, _ item.User, _ *item.UseContext) bool {
-	d.Open = !d.Open
-	w.SetBlock(pos, d, nil)
-
-	otherPos := pos.Side(cube.Face(boolByte(!d.Top)))
-	other := w.Block(otherPos)
-	if door, ok := other.(WoodDoor); ok {
-		door.Open = d.Open
-		w.SetBlock(otherPos, door, nil)
-	}
-	if d.Open {
-		w.PlaySound(pos.Vec3Centre(), sound.DoorOpen{Block: d})
-		return true
-	}
-	w.PlaySound(pos.Vec3Centre(), sound.DoorClose{Block: d})
+	setDoorOpen(d, pos, w, !d.Open)
 	return true
 }
 
+// RedstoneUpdate opens or closes the door when either of its halves starts or stops receiving redstone power.
+func (d WoodDoor) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	updateDoorPower(d, pos, w)
+}
+
+// doorState ...
+func (d WoodDoor) doorState() (top, open bool) {
+	return d.Top, d.Open
+}
+
+// withOpen ...
+func (d WoodDoor) withOpen(open bool) world.Block {
+	d.Open = open
+	return d
+}
+
 // BreakInfo ...
 func (d WoodDoor) BreakInfo() BreakInfo {
-	return newBreakInfo(3, alwaysHarvestable, axeEffective, oneOf(d))
+	return newBreakInfo(3, alwaysHarvestable, axeEffective, oneOf(WoodDoor{Wood: d.Wood}))
 }
 
 // SideClosed ...
@@ -29,7 +29,7 @@ type WoodFenceGate struct {
 
 // BreakInfo ...
 func (f WoodFenceGate) BreakInfo() BreakInfo {
-	return newBreakInfo(2, alwaysHarvestable, axeEffective, oneOf(f)).withBlastResistance(15)
+	return newBreakInfo(2, alwaysHarvestable, axeEffective, oneOf(WoodFenceGate{Wood: f.Wood})).withBlastResistance(15)
 }
 
 // FlammabilityInfo ...
@@ -53,6 +53,7 @@ func (f WoodFenceGate) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w
 	}
 	f.Facing = user.Rotation().Direction()
 	f.Lowered = f.shouldBeLowered(pos, w)
+	f.Open = w.RedstonePower(pos) > 0
 
 	place(w, pos, f, user, ctx)
 	return placed(ctx)
@@ -76,17 +77,29 @@ func (f WoodFenceGate) shouldBeLowered(pos cube.Pos, w *world.World) bool {
 
 // Activate ...
 func (f WoodFenceGate) Activate(pos cube.Pos, _ cube.Face, w *world.World, u item.User, _ *item.UseContext) bool {
-	f.Open = !f.Open
-	if f.Open && f.Facing.Opposite() == u.Rotation().Direction() {
+	if !f.Open && f.Facing.Opposite() == u.Rotation().Direction() {
 		f.Facing = f.Facing.Opposite()
 	}
+	f.setOpen(pos, w, !f.Open)
+	return true
+}
+
+// RedstoneUpdate opens or closes the fence gate when it starts or stops receiving redstone power.
+func (f WoodFenceGate) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if powered := w.RedstonePower(pos) > 0; powered != f.Open {
+		f.setOpen(pos, w, powered)
+	}
+}
+
+// setOpen opens or closes the fence gate and plays the matching sound.
+func (f WoodFenceGate) setOpen(pos cube.Pos, w *world.World, open bool) {
+	f.Open = open
 	w.SetBlock(pos, f, nil)
 	if f.Open {
 		w.PlaySound(pos.Vec3Centre(), sound.FenceGateOpen{Block: f})
-		return true
+		return
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.FenceGateClose{Block: f})
-	return true
 }
 
 // SideClosed ...
@@ -50,6 +50,7 @@ func (t WoodTrapdoor) UseOnBlock(pos cube.Pos, face cube.Face, clickPos mgl64.Ve
 	}
 	t.Facing = user.Rotation().Direction().Opposite()
 	t.Top = (clickPos.Y() > 0.5 && face != cube.FaceUp) || face == cube.FaceDown
+	t.Open = w.RedstonePower(pos) > 0
 
 	place(w, pos, t, user, ctx)
 	return placed(ctx)
@@ -57,19 +58,31 @@ func (t WoodTrapdoor) UseOnBlock(pos cube.Pos, face cube.Face, clickPos mgl64.Ve
 
 // Activate ...
 func (t WoodTrapdoor) Activate(pos cube.Pos, _ cube.Face, w *world.World, _ item.User, _ *item.UseContext) bool {
-	t.Open = !t.Open
+	t.setOpen(pos, w, !t.Open)
+	return true
+}
+
+// RedstoneUpdate opens or closes the trapdoor when it starts or stops receiving redstone power.
+func (t WoodTrapdoor) RedstoneUpdate(pos cube.Pos, w *world.World) {
+	if powered := w.RedstonePower(pos) > 0; powered != t.Open {
+		t.setOpen(pos, w, powered)
+	}
+}
+
+// setOpen opens or closes the trapdoor and plays the matching sound.
+func (t WoodTrapdoor) setOpen(pos cube.Pos, w *world.World, open bool) {
+	t.Open = open
 	w.SetBlock(pos, t, nil)
 	if t.Open {
 		w.PlaySound(pos.Vec3Centre(), sound.TrapdoorOpen{Block: t})
-		return true
+		return
 	}
 	w.PlaySound(pos.Vec3Centre(), sound.TrapdoorClose{Block: t})
-	return true
 }
 
 // BreakInfo ...
 func (t WoodTrapdoor) BreakInfo() BreakInfo {
-	return newBreakInfo(3, alwaysHarvestable, axeEffective, oneOf(t))
+	return newBreakInfo(3, alwaysHarvestable, axeEffective, oneOf(WoodTrapdoor{Wood: t.Wood}))
 }
 
 // FuelInfo ...
@@ -19,6 +19,11 @@ func (w Wool) Instrument() sound.Instrument {
 	return sound.Guitar()
 }
 
+// OccludesVibrations ...
+func (Wool) OccludesVibrations() bool {
+	return true
+}
+
 // FlammabilityInfo ...
 func (w Wool) FlammabilityInfo() FlammabilityInfo {
 	return newFlammabilityInfo(30, 60, true)
@@ -47,6 +47,13 @@ type FireworkExplosionAction struct{ action }
 // TotemUseAction is a world.EntityAction that displays the totem use particles and animation.
 type TotemUseAction struct{ action }
 
+// WakeUpAction is a world.EntityAction that makes a sleeping entity wake up and get out of its bed.
+type WakeUpAction struct{ action }
+
+// EatGrassAction is a world.EntityAction that makes an entity, such as a sheep, display the animation of eating
+// the grass it is standing on.
+type EatGrassAction struct{ action }
+
 // action implements the Action interface. Structures in this package may embed it to gets its functionality
 // out of the box.
 type action struct{}
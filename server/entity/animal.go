@@ -0,0 +1,268 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"sync"
+)
+
+const (
+	// babyGrowthTicks is the amount of ticks that it takes for a baby animal to grow up.
+	babyGrowthTicks = 24000
+	// breedCooldownTicks is the amount of ticks that an animal must wait after breeding before it can breed
+	// again.
+	breedCooldownTicks = 6000
+	// loveTicks is the amount of ticks that an animal stays in love after being fed.
+	loveTicks = 600
+)
+
+// Animal is a Mob that may be bred by feeding it its food and that grows up from a baby into an adult. Animals
+// such as a Cow or a Pig embed an Animal.
+type Animal struct {
+	*Mob
+
+	food      func(it world.Item) bool
+	offspring func(pos mgl64.Vec3, partner *Animal) breedable
+
+	amu sync.Mutex
+	// growth is negative while the animal is a baby, in which case it is the negated amount of ticks left until
+	// the animal grows up. If positive, it is the amount of ticks left until the animal can breed again.
+	growth int
+	love   int
+}
+
+// breedable is implemented by entities that embed an Animal.
+type breedable interface {
+	world.Entity
+	animal() *Animal
+}
+
+// newAnimal creates an Animal embedded in the entity self using conf. The food function returns true for items
+// that the animal may be bred with. The offspring function creates the baby that is born from the animal and
+// its partner at a position.
+func newAnimal(conf MobConfig, t world.EntityType, pos mgl64.Vec3, self world.Entity, food func(it world.Item) bool, offspring func(pos mgl64.Vec3, partner *Animal) breedable) *Animal {
+	a := &Animal{food: food, offspring: offspring}
+	tick := conf.Tick
+	conf.Tick = func(m *Mob) {
+		a.tick()
+		if tick != nil {
+			tick(m)
+		}
+	}
+	a.Mob = conf.new(t, pos, self)
+	return a
+}
+
+// animal returns the Animal itself.
+func (a *Animal) animal() *Animal {
+	return a
+}
+
+// Persistent always returns true: Animals are never despawned.
+func (a *Animal) Persistent() bool {
+	return true
+}
+
+// Food checks if the item passed may be fed to the animal to breed it or to make it grow up faster.
+func (a *Animal) Food(it world.Item) bool {
+	return it != nil && a.food(it)
+}
+
+// Baby checks if the animal is a baby.
+func (a *Animal) Baby() bool {
+	a.amu.Lock()
+	defer a.amu.Unlock()
+	return a.growth < 0
+}
+
+// SetBaby turns the animal into a baby if true is passed, or into an adult if false is passed.
+func (a *Animal) SetBaby(baby bool) {
+	a.amu.Lock()
+	if baby {
+		a.growth = -babyGrowthTicks
+	} else {
+		a.growth = 0
+	}
+	a.amu.Unlock()
+	a.viewState(a.Position())
+}
+
+// Scale returns the scale of the animal. Babies are half the size of adults.
+func (a *Animal) Scale() float64 {
+	if a.Baby() {
+		return 0.5
+	}
+	return 1
+}
+
+// InLove checks if the animal is in love, meaning it is looking for a partner to breed with.
+func (a *Animal) InLove() bool {
+	a.amu.Lock()
+	defer a.amu.Unlock()
+	return a.love > 0
+}
+
+// CanBreed checks if the animal is able to fall in love, meaning it is an adult that has not recently bred.
+func (a *Animal) CanBreed() bool {
+	a.amu.Lock()
+	defer a.amu.Unlock()
+	return a.growth == 0 && a.love == 0
+}
+
+// SetInLove makes the animal fall in love if true is passed, or stops it from being in love if false is passed.
+func (a *Animal) SetInLove(love bool) {
+	a.amu.Lock()
+	if love {
+		a.love = loveTicks
+	} else {
+		a.love = 0
+	}
+	a.amu.Unlock()
+	a.viewState(a.Position())
+}
+
+// Grow makes a baby animal grow up faster by the number of ticks passed. Grow has no effect on adults.
+func (a *Animal) Grow(ticks int) {
+	a.amu.Lock()
+	if a.growth >= 0 {
+		a.amu.Unlock()
+		return
+	}
+	a.growth = min(a.growth+ticks, 0)
+	grown := a.growth == 0
+	a.amu.Unlock()
+	if grown {
+		a.viewState(a.Position())
+	}
+}
+
+// Interact feeds the item held by the user to the animal if it is the food of the animal. Babies grow up faster
+// when fed, while adults fall in love.
+func (a *Animal) Interact(user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if !a.Food(held.Item()) || a.Dead() {
+		return false
+	}
+	a.amu.Lock()
+	growth := a.growth
+	a.amu.Unlock()
+
+	switch {
+	case growth < 0:
+		// Feeding a baby makes it grow up 10% faster.
+		a.Grow(-growth / 10)
+	case a.CanBreed():
+		a.SetInLove(true)
+	default:
+		return false
+	}
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// breed makes the animal breed with the partner passed, spawning a baby between them and dropping experience.
+func (a *Animal) breed(partner *Animal) {
+	w := a.World()
+	if w == nil {
+		return
+	}
+	pos := a.Position()
+	baby := a.offspring(pos, partner)
+	baby.animal().SetBaby(true)
+	baby.animal().Mob.rot = a.Rotation()
+	w.AddEntity(baby)
+
+	for _, parent := range []*Animal{a, partner} {
+		parent.amu.Lock()
+		parent.growth, parent.love = breedCooldownTicks, 0
+		parent.amu.Unlock()
+		parent.viewState(parent.Position())
+	}
+	for _, orb := range NewExperienceOrbs(pos, rand.Intn(7)+1) {
+		w.AddEntity(orb)
+	}
+}
+
+// tick ticks the growth and love of the animal.
+func (a *Animal) tick() {
+	a.amu.Lock()
+	grown := a.growth == -1
+	if a.growth < 0 {
+		a.growth++
+	} else if a.growth > 0 {
+		a.growth--
+	}
+	stopped := a.love == 1
+	if a.love > 0 {
+		a.love--
+	}
+	a.amu.Unlock()
+
+	if grown || stopped {
+		a.viewState(a.Position())
+	}
+}
+
+// drops returns the item stacks passed if the animal is an adult, or nothing if the animal is a baby.
+func (a *Animal) drops(stacks ...item.Stack) []item.Stack {
+	if a.Baby() {
+		return nil
+	}
+	drops := make([]item.Stack, 0, len(stacks))
+	for _, s := range stacks {
+		if !s.Empty() {
+			drops = append(drops, s)
+		}
+	}
+	return drops
+}
+
+// experience returns 1-3 experience if the animal is an adult, or 0 if the animal is a baby.
+func (a *Animal) experience() int {
+	if a.Baby() {
+		return 0
+	}
+	return rand.Intn(3) + 1
+}
+
+// animalSpawnable checks if an animal may spawn naturally at a position. Animals only spawn on grass.
+func animalSpawnable(pos cube.Pos, w *world.World) bool {
+	_, ok := w.Block(pos.Side(cube.FaceDown)).(block.Grass)
+	return ok
+}
+
+// addAnimalGoals adds the goals shared by all animals to the animal passed. The speed passed is the multiple of
+// its movement speed that the animal moves at when tempted by food or following its parent.
+func addAnimalGoals(a *Animal, speed float64) {
+	goals := a.Goals()
+	goals.Add(0, NewFloatGoal())
+	goals.Add(1, NewPanicGoal(2))
+	goals.Add(2, NewBreedGoal(1))
+	goals.Add(3, NewTemptGoal(speed, a.Food))
+	goals.Add(4, NewFollowParentGoal(speed))
+	goals.Add(6, NewWanderGoal(1))
+	goals.Add(7, NewLookAtPlayerGoal(6))
+	goals.Add(8, NewLookAroundGoal())
+}
+
+// encodeAnimalNBT encodes the animal passed to a map that can be encoded to NBT.
+func encodeAnimalNBT(a *Animal) map[string]any {
+	a.amu.Lock()
+	growth, love := a.growth, a.love
+	a.amu.Unlock()
+
+	data := encodeMobNBT(a.Mob)
+	data["Age"], data["InLove"], data["IsBaby"] = int32(growth), int32(love), boolByte(growth < 0)
+	return data
+}
+
+// decodeAnimalNBT decodes the data passed into the animal passed.
+func decodeAnimalNBT(m map[string]any, a *Animal) {
+	decodeMobNBT(m, a.Mob)
+	a.growth, a.love = int(nbtconv.Int32(m, "Age")), int(nbtconv.Int32(m, "InLove"))
+}
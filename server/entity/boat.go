@@ -0,0 +1,439 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// boatHeight is the height of the bounding box of a boat.
+const boatHeight = 0.455
+
+// Boat is a vehicle that floats on water and that players may ride and paddle. A boat slides over ice and breaks
+// when attacked, dropping the boat item. A boat with a chest has a single seat and a 27-slot inventory, which is
+// opened by interacting with the boat while sneaking.
+type Boat struct {
+	wood  block.WoodType
+	chest bool
+
+	mc         *MovementComputer
+	passengers *PassengerManager
+	inv        *inventory.Inventory
+	viewers    containerViewers
+
+	mu  sync.Mutex
+	pos mgl64.Vec3
+	vel mgl64.Vec3
+	rot cube.Rotation
+	age time.Duration
+
+	deltaYaw   float64
+	input      world.VehicleInput
+	paddling   [2]bool
+	paddleTime [2]float64
+	damage     float64
+}
+
+// NewBoat creates a new Boat of the wood type passed at the position passed, facing in the direction of the yaw
+// passed.
+func NewBoat(pos mgl64.Vec3, yaw float64, wood block.WoodType) *Boat {
+	return &Boat{
+		wood:       wood,
+		pos:        pos,
+		rot:        cube.Rotation{yaw, 0},
+		mc:         &MovementComputer{},
+		passengers: NewPassengerManager(mgl64.Vec3{0, 0.2, 0.2}, mgl64.Vec3{0, 0.2, -0.6}),
+	}
+}
+
+// NewChestBoat creates a new Boat with a chest of the wood type passed at the position passed, facing in the
+// direction of the yaw passed.
+func NewChestBoat(pos mgl64.Vec3, yaw float64, wood block.WoodType) *Boat {
+	b := NewBoat(pos, yaw, wood)
+	b.chest, b.inv = true, b.viewers.newInventory(27)
+	b.passengers = NewPassengerManager(mgl64.Vec3{0, 0.2, 0.2})
+	return b
+}
+
+// Type returns BoatType, or ChestBoatType if the boat has a chest.
+func (b *Boat) Type() world.EntityType {
+	if b.chest {
+		return ChestBoatType{}
+	}
+	return BoatType{}
+}
+
+// Wood returns the wood type of the boat.
+func (b *Boat) Wood() block.WoodType {
+	return b.wood
+}
+
+// Chest checks if the boat has a chest.
+func (b *Boat) Chest() bool {
+	return b.chest
+}
+
+// Inventory returns the inventory of the chest of the boat. Nil is returned if the boat has no chest.
+func (b *Boat) Inventory() *inventory.Inventory {
+	return b.inv
+}
+
+// AddViewer adds a viewer to the inventory of the boat, so that it is updated whenever the inventory is changed.
+func (b *Boat) AddViewer(v ContainerViewer) {
+	b.viewers.add(v)
+}
+
+// RemoveViewer removes a viewer from the inventory of the boat, so that changes in the inventory are no longer
+// sent to it.
+func (b *Boat) RemoveViewer(v ContainerViewer) {
+	b.viewers.remove(v)
+}
+
+// Position ...
+func (b *Boat) Position() mgl64.Vec3 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.pos
+}
+
+// Velocity ...
+func (b *Boat) Velocity() mgl64.Vec3 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.vel
+}
+
+// SetVelocity ...
+func (b *Boat) SetVelocity(v mgl64.Vec3) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.vel = v
+}
+
+// Rotation ...
+func (b *Boat) Rotation() cube.Rotation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.rot
+}
+
+// World ...
+func (b *Boat) World() *world.World {
+	w, _ := world.OfEntity(b)
+	return w
+}
+
+// Age returns the total time lived of the boat.
+func (b *Boat) Age() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.age
+}
+
+// Variant returns the variant of the boat, which decides its wood type client-side.
+func (b *Boat) Variant() int32 {
+	switch b.wood {
+	case block.SpruceWood():
+		return 1
+	case block.BirchWood():
+		return 2
+	case block.JungleWood():
+		return 3
+	case block.AcaciaWood():
+		return 4
+	case block.DarkOakWood():
+		return 5
+	case block.Mangrove():
+		return 6
+	case block.Cherry():
+		return 8
+	}
+	return 0
+}
+
+// PaddleTime returns the progress of the rowing animation of the left and right paddle of the boat.
+func (b *Boat) PaddleTime() (left, right float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.paddleTime[0], b.paddleTime[1]
+}
+
+// Passengers ...
+func (b *Boat) Passengers() []world.Rider {
+	return b.passengers.Passengers()
+}
+
+// Mount makes the rider passed ride the boat. A boat without a chest has two seats and a boat with a chest
+// has one. False is returned if all seats are taken.
+func (b *Boat) Mount(r world.Rider) bool {
+	return b.passengers.Mount(b, r)
+}
+
+// Dismount ...
+func (b *Boat) Dismount(r world.Rider) {
+	b.passengers.Dismount(b, r)
+}
+
+// SeatOffset ...
+func (b *Boat) SeatOffset(r world.Rider) (mgl64.Vec3, bool) {
+	return b.passengers.SeatOffset(r)
+}
+
+// Drive updates the input of the driver of the boat, which is used to paddle the boat during the next tick.
+func (b *Boat) Drive(_ world.Rider, input world.VehicleInput) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.input = input
+}
+
+// Interact makes the user ride the boat. If the boat has a chest and the user is sneaking, its inventory is
+// opened instead.
+func (b *Boat) Interact(user item.User, _ *item.UseContext) bool {
+	if s, ok := user.(interface{ Sneaking() bool }); ok && s.Sneaking() && b.chest {
+		if o, ok := user.(containerOpener); ok {
+			o.OpenEntityContainer(b)
+			return true
+		}
+	}
+	if r, ok := user.(world.Rider); ok {
+		return b.Mount(r)
+	}
+	return false
+}
+
+// Attack damages the boat. The boat breaks and drops its item once it has taken enough damage in a short time,
+// or right away if attacked by a player in creative mode.
+func (b *Boat) Attack(attacker world.Entity, held item.Stack) {
+	if g, ok := attacker.(interface{ GameMode() world.GameMode }); ok && g.GameMode().CreativeInventory() {
+		b.destroy(false)
+		return
+	}
+	b.mu.Lock()
+	b.damage += held.AttackDamage() * 10
+	broken := b.damage > 40
+	b.mu.Unlock()
+
+	if broken {
+		b.destroy(true)
+		return
+	}
+	for _, v := range b.World().Viewers(b.Position()) {
+		v.ViewEntityAction(b, HurtAction{})
+	}
+}
+
+// Explode breaks the boat, dropping its item.
+func (b *Boat) Explode(mgl64.Vec3, float64, block.ExplosionConfig) {
+	b.destroy(true)
+}
+
+// destroy removes the boat from the world. If drop is true, the boat item and the contents of its chest are
+// dropped.
+func (b *Boat) destroy(drop bool) {
+	w, pos := b.World(), b.Position()
+	if w == nil {
+		return
+	}
+	if drop {
+		drops := []item.Stack{item.NewStack(block.Boat{Wood: b.wood, Chest: b.chest}, 1)}
+		if b.inv != nil {
+			drops = append(drops, b.inv.Clear()...)
+		}
+		for _, it := range drops {
+			i := NewItem(it, pos.Add(mgl64.Vec3{0, 0.5}))
+			i.vel = mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1}
+			w.AddEntity(i)
+		}
+	}
+	_ = b.Close()
+}
+
+// Tick moves the boat, letting it float in water and steering it using the input of its driver.
+func (b *Boat) Tick(w *world.World, current int64) {
+	b.mu.Lock()
+	pos, vel, input := b.pos, b.vel, b.input
+	b.damage = max(b.damage-1, 0)
+	b.age += time.Second / 20
+	b.mu.Unlock()
+
+	if pos[1] < float64(w.Range()[0]) && current%10 == 0 {
+		_ = b.Close()
+		return
+	}
+	if _, ok := b.passengers.Driver(); !ok {
+		input = world.VehicleInput{}
+	}
+
+	// Boats float up to the surface of water and are pulled down by gravity when out of it. Out of water, and
+	// in it, boats are slowed down by drag. On the ground, the MovementComputer slows the boat down using the
+	// friction of the block below, which lets boats slide over ice.
+	friction := 0.9
+	if surface, ok := boatWaterSurface(w, pos); ok && surface > pos[1] {
+		vel[1] += (surface - pos[1]) / boatHeight * 0.0615
+		vel[1] *= 0.75
+	} else {
+		vel[1] -= 0.04
+	}
+	if b.mc.OnGround() {
+		friction = 0.6
+		if f, ok := w.Block(cube.PosFromVec3(pos).Side(cube.FaceDown)).(block.Frictional); ok {
+			friction = f.Friction()
+		}
+	} else {
+		vel[0], vel[2] = vel[0]*friction, vel[2]*friction
+	}
+
+	b.mu.Lock()
+	b.deltaYaw *= friction
+	thrust := 0.0
+	if input.Strafe > 0 {
+		b.deltaYaw--
+	} else if input.Strafe < 0 {
+		b.deltaYaw++
+	}
+	if input.Strafe != 0 && input.Forward == 0 {
+		thrust += 0.005
+	}
+	if input.Forward > 0 {
+		thrust += 0.04
+	} else if input.Forward < 0 {
+		thrust -= 0.005
+	}
+	rot := cube.Rotation{b.rot.Yaw() + b.deltaYaw, 0}
+	vel = vel.Add(cube.Rotation{rot.Yaw(), 0}.Vec3().Mul(thrust))
+
+	paddling := [2]bool{input.Strafe < 0 || input.Forward > 0, input.Strafe > 0 || input.Forward > 0}
+	paddled := paddling != b.paddling || paddling[0] || paddling[1]
+	for i, p := range paddling {
+		if !p {
+			b.paddleTime[i] = 0
+			continue
+		}
+		b.paddleTime[i] = math.Mod(b.paddleTime[i]+math.Pi/8, math.Pi*2)
+	}
+	b.paddling = paddling
+	turned := math.Abs(b.deltaYaw) > 0.01
+
+	m := b.mc.TickMovement(b, b.pos, vel, rot)
+	b.pos, b.vel, b.rot = m.pos, m.vel, m.rot
+	b.mu.Unlock()
+
+	m.Send()
+	if turned && m.dpos.ApproxEqualThreshold(zeroVec3, epsilon) {
+		// Movement.Send only sends movement if the position changed, so we send the rotation of the boat
+		// ourselves if it turned without moving.
+		for _, v := range m.v {
+			v.ViewEntityMovement(b, m.pos, m.rot, m.onGround)
+		}
+	}
+	if paddled {
+		for _, v := range m.v {
+			v.ViewEntityState(b)
+		}
+	}
+	b.passengers.Tick(b)
+}
+
+// boatWaterSurface returns the height of the surface of the water that a boat at the position passed floats in.
+// False is returned if there is no water at the position.
+func boatWaterSurface(w *world.World, pos mgl64.Vec3) (float64, bool) {
+	base := cube.PosFromVec3(pos)
+	surface, found := 0.0, false
+	for y := base[1]; y <= base[1]+1; y++ {
+		water, ok := w.Liquid(cube.Pos{base[0], y, base[2]})
+		if !ok {
+			continue
+		}
+		if wa, ok := water.(block.Water); ok {
+			height := float64(wa.Depth) / 9
+			if wa.Falling {
+				height = 1
+			}
+			surface, found = float64(y)+height, true
+		}
+	}
+	return surface, found
+}
+
+// Close removes the boat from the world after dismounting all of its riders and closing its inventory for all of
+// its viewers.
+func (b *Boat) Close() error {
+	b.passengers.DismountAll(b)
+	b.viewers.closeAll(b)
+	if w := b.World(); w != nil {
+		w.RemoveEntity(b)
+	}
+	return nil
+}
+
+// BoatType is a world.EntityType implementation for Boat.
+type BoatType struct{}
+
+func (BoatType) EncodeEntity() string   { return "minecraft:boat" }
+func (BoatType) NetworkOffset() float64 { return 0.375 }
+func (BoatType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.7, 0, -0.7, 0.7, boatHeight, 0.7)
+}
+
+func (BoatType) DecodeNBT(m map[string]any) world.Entity {
+	b := NewBoat(nbtconv.Vec3(m, "Pos"), nbtconv.Rotation(m).Yaw(), boatWood(nbtconv.Int32(m, "Variant")))
+	b.vel = nbtconv.Vec3(m, "Motion")
+	return b
+}
+
+func (BoatType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeBoatNBT(e.(*Boat))
+}
+
+// ChestBoatType is a world.EntityType implementation for Boat with a chest.
+type ChestBoatType struct{}
+
+func (ChestBoatType) EncodeEntity() string   { return "minecraft:chest_boat" }
+func (ChestBoatType) NetworkOffset() float64 { return 0.375 }
+func (ChestBoatType) BBox(e world.Entity) cube.BBox {
+	return BoatType{}.BBox(e)
+}
+
+func (ChestBoatType) DecodeNBT(m map[string]any) world.Entity {
+	b := NewChestBoat(nbtconv.Vec3(m, "Pos"), nbtconv.Rotation(m).Yaw(), boatWood(nbtconv.Int32(m, "Variant")))
+	b.vel = nbtconv.Vec3(m, "Motion")
+	nbtconv.InvFromNBT(b.inv, nbtconv.Slice(m, "Items"))
+	return b
+}
+
+func (ChestBoatType) EncodeNBT(e world.Entity) map[string]any {
+	b := e.(*Boat)
+	data := encodeBoatNBT(b)
+	data["Items"] = nbtconv.InvToNBT(b.inv)
+	return data
+}
+
+// encodeBoatNBT encodes the boat passed to a map that can be encoded to NBT.
+func encodeBoatNBT(b *Boat) map[string]any {
+	yaw, pitch := b.Rotation().Elem()
+	return map[string]any{
+		"Pos":     nbtconv.Vec3ToFloat32Slice(b.Position()),
+		"Motion":  nbtconv.Vec3ToFloat32Slice(b.Velocity()),
+		"Yaw":     float32(yaw),
+		"Pitch":   float32(pitch),
+		"Variant": b.Variant(),
+	}
+}
+
+// boatWood returns the wood type of the boat variant passed.
+func boatWood(variant int32) block.WoodType {
+	for _, w := range block.WoodTypes() {
+		if (&Boat{wood: w}).Variant() == variant {
+			return w
+		}
+	}
+	return block.OakWood()
+}
@@ -0,0 +1,69 @@
+package entity
+
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
+// BreedGoal is a Goal that makes an Animal that is in love move towards another Animal of the same type that is
+// in love, after which the two breed and a baby is born.
+type BreedGoal struct {
+	speed   float64
+	partner *Animal
+	ticks   int
+}
+
+// NewBreedGoal creates a BreedGoal that makes an Animal move towards its partner at the multiple of its speed
+// passed.
+func NewBreedGoal(speed float64) *BreedGoal {
+	return &BreedGoal{speed: speed}
+}
+
+// Flags ...
+func (*BreedGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook
+}
+
+// CanStart ...
+func (g *BreedGoal) CanStart(m *Mob) bool {
+	a, ok := m.Entity().(breedable)
+	if !ok || !a.animal().InLove() {
+		return false
+	}
+	partner := nearestEntity(m, 8, func(e world.Entity) bool {
+		other, ok := e.(breedable)
+		return ok && e.Type() == m.Type() && other.animal().InLove() && !other.animal().Baby()
+	})
+	if partner == nil {
+		return false
+	}
+	g.partner = partner.(breedable).animal()
+	return true
+}
+
+// CanContinue ...
+func (g *BreedGoal) CanContinue(m *Mob) bool {
+	p := g.partner
+	return p != nil && !p.Dead() && p.World() == m.World() && p.InLove() && g.ticks < 60
+}
+
+// Start ...
+func (g *BreedGoal) Start(*Mob) {
+	g.ticks = 0
+}
+
+// Stop ...
+func (g *BreedGoal) Stop(m *Mob) {
+	g.partner = nil
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *BreedGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.partner))
+	if g.ticks%10 == 0 {
+		m.Navigate(g.partner.Position(), g.speed)
+	}
+	if g.ticks++; g.ticks >= 60 && m.Position().Sub(g.partner.Position()).Len() < 3 {
+		if a, ok := m.Entity().(breedable); ok && a.animal().InLove() {
+			a.animal().breed(g.partner)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"sync"
+)
+
+// Chicken is a passive animal that lays an egg every few minutes and drops feathers and chicken when killed.
+// Chickens fall slowly and do not take fall damage.
+type Chicken struct {
+	*Animal
+
+	mu sync.Mutex
+	// eggTicks is the amount of ticks left until the chicken lays its next egg.
+	eggTicks int
+}
+
+// chickenPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every chicken.
+var chickenPathfinder = pathfind.Config{Width: 0.6, Height: 0.8, CanSwim: true, MaxFallDistance: 8}
+
+// NewChicken creates a new adult Chicken at the position passed.
+func NewChicken(pos mgl64.Vec3) *Chicken {
+	c := &Chicken{eggTicks: randomEggTicks()}
+	c.Animal = newAnimal(MobConfig{
+		MaxHealth:         4,
+		EyeHeight:         0.6,
+		Pathfinder:        chickenPathfinder.New(),
+		DisableFallDamage: true,
+		Drops: func(*Mob, world.DamageSource) []item.Stack {
+			return c.drops(
+				item.NewStack(item.Feather{}, rand.Intn(3)),
+				item.NewStack(item.Chicken{Cooked: c.OnFireDuration() > 0}, 1),
+			)
+		},
+		Experience: func(*Mob) int { return c.experience() },
+		Tick: func(*Mob) {
+			c.slowFall()
+			c.tickEgg()
+		},
+	}, ChickenType{}, pos, c, chickenFood, func(pos mgl64.Vec3, _ *Animal) breedable {
+		return NewChicken(pos)
+	})
+	addAnimalGoals(c.Animal, 1)
+	return c
+}
+
+// chickenFood checks if the item passed may be fed to a chicken.
+func chickenFood(it world.Item) bool {
+	switch it.(type) {
+	case block.WheatSeeds, block.BeetrootSeeds, block.MelonSeeds, block.PumpkinSeeds:
+		return true
+	}
+	return false
+}
+
+// randomEggTicks returns a random amount of ticks between 5 and 10 minutes until a chicken lays an egg.
+func randomEggTicks() int {
+	return rand.Intn(6000) + 6000
+}
+
+// slowFall slows down the fall of the chicken, as it flaps its wings while falling.
+func (c *Chicken) slowFall() {
+	if vel := c.Velocity(); !c.OnGround() && vel[1] < 0 {
+		vel[1] *= 0.6
+		c.SetVelocity(vel)
+	}
+}
+
+// tickEgg ticks the timer of the chicken until it lays its next egg, laying an egg once it runs out.
+func (c *Chicken) tickEgg() {
+	if c.Baby() {
+		return
+	}
+	c.mu.Lock()
+	c.eggTicks--
+	lay := c.eggTicks <= 0
+	if lay {
+		c.eggTicks = randomEggTicks()
+	}
+	c.mu.Unlock()
+
+	if lay {
+		w, pos := c.World(), c.Position()
+		w.PlaySound(pos, sound.Pop{})
+		egg := NewItem(item.NewStack(item.Egg{}, 1), pos)
+		egg.vel = mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1}
+		w.AddEntity(egg)
+	}
+}
+
+// ChickenType is a world.EntityType implementation for Chicken.
+type ChickenType struct{}
+
+func (ChickenType) EncodeEntity() string           { return "minecraft:chicken" }
+func (ChickenType) MobCategory() world.MobCategory { return world.CreatureCategory() }
+func (ChickenType) CanSpawn(pos cube.Pos, w *world.World) bool {
+	return animalSpawnable(pos, w)
+}
+func (ChickenType) Spawn(pos mgl64.Vec3) world.Entity { return NewChicken(pos) }
+func (ChickenType) BBox(e world.Entity) cube.BBox {
+	if a, ok := e.(breedable); ok && a.animal().Baby() {
+		return cube.Box(-0.15, 0, -0.15, 0.15, 0.4, 0.15)
+	}
+	return cube.Box(-0.3, 0, -0.3, 0.3, 0.8, 0.3)
+}
+
+func (ChickenType) DecodeNBT(m map[string]any) world.Entity {
+	c := NewChicken(nbtconv.Vec3(m, "Pos"))
+	decodeAnimalNBT(m, c.Animal)
+	if ticks := int(nbtconv.Int32(m, "EggTicks")); ticks > 0 {
+		c.eggTicks = ticks
+	}
+	return c
+}
+
+func (ChickenType) EncodeNBT(e world.Entity) map[string]any {
+	c := e.(*Chicken)
+	data := encodeAnimalNBT(c.Animal)
+	c.mu.Lock()
+	data["EggTicks"] = int32(c.eggTicks)
+	c.mu.Unlock()
+	return data
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"golang.org/x/exp/maps"
+	"sync"
+)
+
+// ContainerViewer is a viewer of the inventory of an entity, such as a boat with a chest.
+type ContainerViewer interface {
+	block.ContainerViewer
+	// CloseEntityContainer closes the inventory of the entity passed if the viewer currently has it opened.
+	CloseEntityContainer(e world.Entity)
+}
+
+// containerOpener is implemented by entities, such as players, that may open the inventory of an entity, such as
+// a chest boat.
+type containerOpener interface {
+	OpenEntityContainer(e world.Entity)
+}
+
+// containerViewers holds the viewers of the inventory of an entity, so that changes to the inventory are shown to
+// all of them and their windows are closed once the entity is destroyed.
+type containerViewers struct {
+	mu      sync.Mutex
+	viewers map[ContainerViewer]struct{}
+}
+
+// newInventory creates an inventory with the size passed of which all slot changes are sent to the viewers.
+func (c *containerViewers) newInventory(size int) *inventory.Inventory {
+	return inventory.New(size, func(slot int, _, after item.Stack) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for v := range c.viewers {
+			v.ViewSlotChange(slot, after)
+		}
+	})
+}
+
+// add adds a viewer, so that it is updated whenever the inventory is changed.
+func (c *containerViewers) add(v ContainerViewer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.viewers == nil {
+		c.viewers = make(map[ContainerViewer]struct{}, 1)
+	}
+	c.viewers[v] = struct{}{}
+}
+
+// remove removes a viewer, so that changes in the inventory are no longer sent to it.
+func (c *containerViewers) remove(v ContainerViewer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.viewers, v)
+}
+
+// closeAll removes all viewers and closes the inventory of the entity passed for each of them.
+func (c *containerViewers) closeAll(e world.Entity) {
+	c.mu.Lock()
+	viewers := maps.Keys(c.viewers)
+	clear(c.viewers)
+	c.mu.Unlock()
+
+	for _, v := range viewers {
+		v.CloseEntityContainer(e)
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Cow is a passive animal that drops leather and beef when killed and that may be milked using a bucket.
+type Cow struct {
+	*Animal
+}
+
+// cowPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every cow.
+var cowPathfinder = pathfind.Config{Width: 0.9, Height: 1.4, CanSwim: true}
+
+// NewCow creates a new adult Cow at the position passed.
+func NewCow(pos mgl64.Vec3) *Cow {
+	c := &Cow{}
+	c.Animal = newAnimal(MobConfig{
+		MaxHealth:  10,
+		EyeHeight:  1.3,
+		Pathfinder: cowPathfinder.New(),
+		Drops: func(*Mob, world.DamageSource) []item.Stack {
+			return c.drops(
+				item.NewStack(item.Leather{}, rand.Intn(3)),
+				item.NewStack(item.Beef{Cooked: c.OnFireDuration() > 0}, rand.Intn(3)+1),
+			)
+		},
+		Experience: func(*Mob) int { return c.experience() },
+	}, CowType{}, pos, c, func(it world.Item) bool {
+		_, ok := it.(item.Wheat)
+		return ok
+	}, func(pos mgl64.Vec3, _ *Animal) breedable {
+		return NewCow(pos)
+	})
+	addAnimalGoals(c.Animal, 1.25)
+	return c
+}
+
+// Interact milks the cow if the user interacts with it using an empty bucket. If not, the item held is fed to
+// the cow if it is wheat.
+func (c *Cow) Interact(user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if b, ok := held.Item().(item.Bucket); ok && b.Empty() && !c.Baby() && !c.Dead() {
+		ctx.SubtractFromCount(1)
+		ctx.NewItem = item.NewStack(item.Bucket{Content: item.MilkBucketContent()}, 1)
+		c.World().PlaySound(c.Position(), sound.Milk{})
+		return true
+	}
+	return c.Animal.Interact(user, ctx)
+}
+
+// CowType is a world.EntityType implementation for Cow.
+type CowType struct{}
+
+func (CowType) EncodeEntity() string           { return "minecraft:cow" }
+func (CowType) MobCategory() world.MobCategory { return world.CreatureCategory() }
+func (CowType) CanSpawn(pos cube.Pos, w *world.World) bool {
+	return animalSpawnable(pos, w)
+}
+func (CowType) Spawn(pos mgl64.Vec3) world.Entity { return NewCow(pos) }
+func (CowType) BBox(e world.Entity) cube.BBox {
+	if a, ok := e.(breedable); ok && a.animal().Baby() {
+		return cube.Box(-0.225, 0, -0.225, 0.225, 0.7, 0.225)
+	}
+	return cube.Box(-0.45, 0, -0.45, 0.45, 1.4, 0.45)
+}
+
+func (CowType) DecodeNBT(m map[string]any) world.Entity {
+	c := NewCow(nbtconv.Vec3(m, "Pos"))
+	decodeAnimalNBT(m, c.Animal)
+	return c
+}
+
+func (CowType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeAnimalNBT(e.(*Cow).Animal)
+}
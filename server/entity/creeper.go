@@ -0,0 +1,228 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"sync"
+)
+
+// creeperFuseTicks is the amount of ticks that a creeper swells for before it explodes.
+const creeperFuseTicks = 30
+
+// Creeper is a Monster that silently approaches players and swells up once it is close to them, exploding if
+// the player does not get away in time. Creepers struck by lightning become charged, doubling the size of their
+// explosion.
+type Creeper struct {
+	*Monster
+
+	mu sync.Mutex
+	// fuse is the amount of ticks that the creeper has been swelling for. swell is 1 while the creeper is
+	// swelling and -1 while it is shrinking back.
+	fuse, swell      int
+	ignited, charged bool
+}
+
+// creeperPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every creeper.
+var creeperPathfinder = pathfind.Config{Width: 0.6, Height: 1.7, CanSwim: true}
+
+// NewCreeper creates a new Creeper at the position passed.
+func NewCreeper(pos mgl64.Vec3) *Creeper {
+	c := &Creeper{swell: -1}
+	c.Monster = newMonster(MobConfig{
+		EyeHeight:  1.4,
+		Pathfinder: creeperPathfinder.New(),
+		Drops: func(*Mob, world.DamageSource) []item.Stack {
+			return []item.Stack{item.NewStack(item.Gunpowder{}, rand.Intn(3))}
+		},
+		Experience: monsterExperience,
+		Tick:       func(*Mob) { c.tickFuse() },
+	}, CreeperType{}, pos, c, false)
+
+	goals, targets := c.Goals(), c.Targets()
+	goals.Add(1, NewFloatGoal())
+	goals.Add(2, &swellGoal{})
+	goals.Add(4, NewMeleeAttackGoal(1))
+	goals.Add(5, NewWanderGoal(0.8))
+	goals.Add(6, NewLookAtPlayerGoal(8))
+	goals.Add(6, NewLookAroundGoal())
+	targets.Add(1, NewNearestPlayerTargetGoal(16))
+	targets.Add(2, NewHurtByTargetGoal())
+	return c
+}
+
+// Swelling checks if the creeper is currently swelling up, meaning it is about to explode.
+func (c *Creeper) Swelling() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.swell > 0 || c.ignited
+}
+
+// Ignite ignites the creeper, as happens when a player uses flint and steel on it. An ignited creeper explodes
+// once its fuse runs out, regardless of whether it has a target.
+func (c *Creeper) Ignite() {
+	c.mu.Lock()
+	c.ignited = true
+	c.mu.Unlock()
+	c.viewState(c.Position())
+}
+
+// Charged checks if the creeper is charged. Charged creepers have an explosion twice as big as normal creepers.
+func (c *Creeper) Charged() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.charged
+}
+
+// SetCharged changes whether the creeper is charged.
+func (c *Creeper) SetCharged(charged bool) {
+	c.mu.Lock()
+	c.charged = charged
+	c.mu.Unlock()
+	c.viewState(c.Position())
+}
+
+// Hurt hurts the creeper like Mob.Hurt. If the creeper is struck by lightning, it becomes charged.
+func (c *Creeper) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
+	if _, ok := src.(LightningDamageSource); ok && !c.Charged() {
+		c.SetCharged(true)
+	}
+	return c.Monster.Hurt(dmg, src)
+}
+
+// Interact ignites the creeper if the user interacts with it using flint and steel.
+func (c *Creeper) Interact(user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if _, ok := held.Item().(item.FlintAndSteel); !ok || c.Swelling() || c.Dead() {
+		return false
+	}
+	c.Ignite()
+	ctx.DamageItem(1)
+	c.World().PlaySound(c.Position(), sound.Ignite{})
+	return true
+}
+
+// setSwell sets the direction in which the fuse of the creeper is ticking. 1 makes the creeper swell up, while
+// -1 makes it shrink back.
+func (c *Creeper) setSwell(swell int) {
+	before := c.Swelling()
+	c.mu.Lock()
+	c.swell = swell
+	c.mu.Unlock()
+	if before != c.Swelling() {
+		c.viewState(c.Position())
+	}
+}
+
+// tickFuse ticks the fuse of the creeper, making it explode once the fuse runs out.
+func (c *Creeper) tickFuse() {
+	c.mu.Lock()
+	if c.ignited {
+		c.swell = 1
+	}
+	before := c.fuse
+	c.fuse = max(c.fuse+c.swell, 0)
+	fuse, charged := c.fuse, c.charged
+	c.mu.Unlock()
+
+	if fuse > 0 && before == 0 {
+		c.World().PlaySound(c.Position(), sound.TNT{})
+	}
+	if fuse >= creeperFuseTicks {
+		c.explode(charged)
+	}
+}
+
+// explode makes the creeper explode, removing it from the world.
+func (c *Creeper) explode(charged bool) {
+	w, pos := c.World(), c.Position()
+	size := 3.0
+	if charged {
+		size *= 2
+	}
+	// The creeper is removed before the explosion, so that it does not get hurt by the explosion itself.
+	_ = c.Close()
+	block.ExplosionConfig{Size: size}.Explode(w, pos)
+}
+
+// swellGoal is a Goal that makes a Creeper swell up once its target is close to it.
+type swellGoal struct{}
+
+// Flags ...
+func (*swellGoal) Flags() GoalFlag {
+	return GoalFlagMove
+}
+
+// CanStart ...
+func (*swellGoal) CanStart(m *Mob) bool {
+	c, ok := m.Entity().(*Creeper)
+	if !ok {
+		return false
+	}
+	target := m.Target()
+	return c.Swelling() || (validTarget(m, target) && target.Position().Sub(m.Position()).Len() < 3)
+}
+
+// CanContinue ...
+func (g *swellGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (*swellGoal) Start(m *Mob) {
+	m.StopNavigating()
+}
+
+// Stop ...
+func (*swellGoal) Stop(m *Mob) {
+	if c, ok := m.Entity().(*Creeper); ok {
+		c.setSwell(-1)
+	}
+}
+
+// Tick ...
+func (*swellGoal) Tick(m *Mob) {
+	c, ok := m.Entity().(*Creeper)
+	if !ok {
+		return
+	}
+	target := m.Target()
+	if !validTarget(m, target) || target.Position().Sub(m.Position()).Len() > 7 || !m.CanSee(target) {
+		c.setSwell(-1)
+		return
+	}
+	c.setSwell(1)
+}
+
+// CreeperType is a world.EntityType implementation for Creeper.
+type CreeperType struct{}
+
+func (CreeperType) EncodeEntity() string                 { return "minecraft:creeper" }
+func (CreeperType) MobCategory() world.MobCategory       { return world.MonsterCategory() }
+func (CreeperType) CanSpawn(cube.Pos, *world.World) bool { return true }
+func (CreeperType) Spawn(pos mgl64.Vec3) world.Entity    { return NewCreeper(pos) }
+func (CreeperType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.7, 0.3)
+}
+
+func (CreeperType) DecodeNBT(m map[string]any) world.Entity {
+	c := NewCreeper(nbtconv.Vec3(m, "Pos"))
+	decodeMobNBT(m, c.Mob)
+	c.charged, c.ignited = nbtconv.Bool(m, "powered"), nbtconv.Bool(m, "ignited")
+	return c
+}
+
+func (CreeperType) EncodeNBT(e world.Entity) map[string]any {
+	c := e.(*Creeper)
+	data := encodeMobNBT(c.Mob)
+	c.mu.Lock()
+	data["powered"], data["ignited"] = boolByte(c.charged), boolByte(c.ignited)
+	c.mu.Unlock()
+	return data
+}
@@ -2,10 +2,12 @@ package entity
 
 import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube/trace"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
 	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
 )
 
 // NewEgg creates an Egg entity. Egg is as a throwable entity that can be used
@@ -14,12 +16,31 @@ func NewEgg(pos mgl64.Vec3, owner world.Entity) *Ent {
 	return Config{Behaviour: eggConf.New(owner)}.New(EggType{}, pos)
 }
 
-// TODO: Spawn chicken(e) 12.5% of the time.
 var eggConf = ProjectileBehaviourConfig{
 	Gravity:       0.03,
 	Drag:          0.01,
 	Particle:      particle.EggSmash{},
 	ParticleCount: 6,
+	Hit:           hatchEgg,
+}
+
+// hatchEgg spawns a baby chicken where the egg passed hits its target 12.5% of the time. In rare cases, four
+// chickens are spawned instead.
+func hatchEgg(e *Ent, _ trace.Result) {
+	if rand.Intn(8) != 0 {
+		return
+	}
+	n := 1
+	if rand.Intn(32) == 0 {
+		n = 4
+	}
+	w := e.World()
+	for i := 0; i < n; i++ {
+		c := NewChicken(e.Position())
+		c.SetBaby(true)
+		c.rot = cube.Rotation{e.Rotation().Yaw()}
+		w.AddEntity(c)
+	}
 }
 
 // EggType is a world.EntityType implementation for Egg.
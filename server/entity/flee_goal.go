@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// PanicGoal is a Goal that makes a Mob run around in panic for a short while after it was hurt or while it is
+// on fire.
+type PanicGoal struct {
+	speed float64
+}
+
+// NewPanicGoal creates a PanicGoal that makes a Mob run at the multiple of its speed passed.
+func NewPanicGoal(speed float64) *PanicGoal {
+	return &PanicGoal{speed: speed}
+}
+
+// Flags ...
+func (*PanicGoal) Flags() GoalFlag {
+	return GoalFlagMove
+}
+
+// CanStart ...
+func (*PanicGoal) CanStart(m *Mob) bool {
+	return m.RecentlyHurt(time.Second*5) || m.OnFireDuration() > 0
+}
+
+// CanContinue ...
+func (*PanicGoal) CanContinue(m *Mob) bool {
+	return m.Navigating()
+}
+
+// Start ...
+func (g *PanicGoal) Start(m *Mob) {
+	var away *mgl64.Vec3
+	if attacker := m.LastAttacker(); attacker != nil && attacker.World() == m.World() {
+		pos := attacker.Position()
+		away = &pos
+	}
+	if pos, ok := randomPositionNear(m, 5, 4, away); ok {
+		m.Navigate(pos, g.speed)
+	}
+}
+
+// Stop ...
+func (*PanicGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// Tick ...
+func (*PanicGoal) Tick(*Mob) {}
+
+// FleeGoal is a Goal that makes a Mob flee from entities near it, such as a creeper fleeing from a cat.
+type FleeGoal struct {
+	speed, distance float64
+	filter          func(e world.Entity) bool
+	from            world.Entity
+}
+
+// NewFleeGoal creates a FleeGoal that makes a Mob flee at the multiple of its speed passed from entities at most
+// distance blocks away for which the filter passed returns true.
+func NewFleeGoal(speed, distance float64, filter func(e world.Entity) bool) *FleeGoal {
+	return &FleeGoal{speed: speed, distance: distance, filter: filter}
+}
+
+// Flags ...
+func (*FleeGoal) Flags() GoalFlag {
+	return GoalFlagMove
+}
+
+// CanStart ...
+func (g *FleeGoal) CanStart(m *Mob) bool {
+	g.from = nearestEntity(m, g.distance, g.filter)
+	return g.from != nil
+}
+
+// CanContinue ...
+func (*FleeGoal) CanContinue(m *Mob) bool {
+	return m.Navigating()
+}
+
+// Start ...
+func (g *FleeGoal) Start(m *Mob) {
+	away := g.from.Position()
+	if pos, ok := randomPositionNear(m, 16, 7, &away); ok {
+		m.Navigate(pos, g.speed)
+	}
+}
+
+// Stop ...
+func (g *FleeGoal) Stop(m *Mob) {
+	g.from = nil
+	m.StopNavigating()
+}
+
+// Tick ...
+func (*FleeGoal) Tick(*Mob) {}
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// FloatGoal is a Goal that makes a Mob swim upwards while it is in water, so that it does not drown.
+type FloatGoal struct{}
+
+// NewFloatGoal creates a FloatGoal.
+func NewFloatGoal() *FloatGoal {
+	return &FloatGoal{}
+}
+
+// Flags ...
+func (*FloatGoal) Flags() GoalFlag {
+	return GoalFlagJump
+}
+
+// CanStart ...
+func (*FloatGoal) CanStart(m *Mob) bool {
+	return m.InWater()
+}
+
+// CanContinue ...
+func (g *FloatGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (*FloatGoal) Start(*Mob) {}
+
+// Stop ...
+func (*FloatGoal) Stop(*Mob) {}
+
+// Tick ...
+func (*FloatGoal) Tick(m *Mob) {
+	if rand.Float64() < 0.8 {
+		m.SetVelocity(m.Velocity().Add(mgl64.Vec3{0, 0.04}))
+	}
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// FollowOwnerGoal is a Goal that makes a Mob follow its owner around, teleporting to the owner if it falls too
+// far behind.
+type FollowOwnerGoal struct {
+	speed, start, stop float64
+	repath             int
+}
+
+// NewFollowOwnerGoal creates a FollowOwnerGoal that makes a Mob follow its owner at the multiple of its speed
+// passed. The Mob starts following its owner once it is further than start blocks away from it, and stops once
+// it is closer than stop blocks.
+func NewFollowOwnerGoal(speed, start, stop float64) *FollowOwnerGoal {
+	return &FollowOwnerGoal{speed: speed, start: start, stop: stop}
+}
+
+// Flags ...
+func (*FollowOwnerGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook
+}
+
+// CanStart ...
+func (g *FollowOwnerGoal) CanStart(m *Mob) bool {
+	owner := m.Owner()
+	return owner != nil && owner.World() == m.World() && owner.Position().Sub(m.Position()).Len() > g.start
+}
+
+// CanContinue ...
+func (g *FollowOwnerGoal) CanContinue(m *Mob) bool {
+	owner := m.Owner()
+	return owner != nil && owner.World() == m.World() && owner.Position().Sub(m.Position()).Len() > g.stop
+}
+
+// Start ...
+func (g *FollowOwnerGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop ...
+func (*FollowOwnerGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *FollowOwnerGoal) Tick(m *Mob) {
+	owner := m.Owner()
+	m.LookAt(EyePosition(owner))
+	if g.repath--; g.repath > 0 {
+		return
+	}
+	g.repath = 10
+	if owner.Position().Sub(m.Position()).Len() >= 12 {
+		g.teleport(m, owner.Position())
+		return
+	}
+	m.Navigate(owner.Position(), g.speed)
+}
+
+// teleport attempts to teleport the Mob to a free position near the position passed.
+func (*FollowOwnerGoal) teleport(m *Mob, pos mgl64.Vec3) {
+	w, origin := m.World(), cube.PosFromVec3(pos)
+	for i := 0; i < 10; i++ {
+		x, z := rand.Intn(7)-3, rand.Intn(7)-3
+		if x >= -1 && x <= 1 && z >= -1 && z <= 1 {
+			// Don't teleport the mob right on top of its owner.
+			continue
+		}
+		if p := origin.Add(cube.Pos{x, rand.Intn(3) - 1, z}); standable(w, p) {
+			m.StopNavigating()
+			m.Teleport(p.Vec3Middle())
+			return
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package entity
+
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
+// FollowParentGoal is a Goal that makes a baby Animal follow the nearest adult Animal of the same type.
+type FollowParentGoal struct {
+	speed  float64
+	parent world.Entity
+	repath int
+}
+
+// NewFollowParentGoal creates a FollowParentGoal that makes a baby Animal follow its parent at the multiple of
+// its speed passed.
+func NewFollowParentGoal(speed float64) *FollowParentGoal {
+	return &FollowParentGoal{speed: speed}
+}
+
+// Flags ...
+func (*FollowParentGoal) Flags() GoalFlag {
+	return GoalFlagMove
+}
+
+// CanStart ...
+func (g *FollowParentGoal) CanStart(m *Mob) bool {
+	if a, ok := m.Entity().(breedable); !ok || !a.animal().Baby() {
+		return false
+	}
+	g.parent = nearestEntity(m, 8, func(e world.Entity) bool {
+		other, ok := e.(breedable)
+		return ok && e.Type() == m.Type() && !other.animal().Baby()
+	})
+	return g.parent != nil && g.parent.Position().Sub(m.Position()).Len() >= 3
+}
+
+// CanContinue ...
+func (g *FollowParentGoal) CanContinue(m *Mob) bool {
+	if a, ok := m.Entity().(breedable); !ok || !a.animal().Baby() {
+		return false
+	}
+	if l, ok := g.parent.(Living); ok && l.Dead() {
+		return false
+	}
+	dist := g.parent.Position().Sub(m.Position()).Len()
+	return g.parent.World() == m.World() && dist >= 3 && dist <= 16
+}
+
+// Start ...
+func (g *FollowParentGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop ...
+func (g *FollowParentGoal) Stop(m *Mob) {
+	g.parent = nil
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *FollowParentGoal) Tick(m *Mob) {
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		m.Navigate(g.parent.Position(), g.speed)
+	}
+}
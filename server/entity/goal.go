@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Goal is a unit of behaviour of a Mob, such as wandering around or attacking its target. Goals are added to
+// the GoalSelector of a Mob with a priority, which decides which goals run when multiple goals want to control
+// the same part of the Mob.
+// Goals may keep state of the Mob they run for between ticks, such as its current target or path. A Goal must
+// therefore be created for every Mob separately and never be added to the selectors of multiple mobs.
+type Goal interface {
+	// Flags returns the controls of the Mob that the Goal uses while running. Two goals that share a control
+	// never run at the same time.
+	Flags() GoalFlag
+	// CanStart checks if the Goal can start running for the Mob passed.
+	CanStart(m *Mob) bool
+	// CanContinue checks if the Goal, which is currently running, should continue running for the Mob passed.
+	CanContinue(m *Mob) bool
+	// Start is called when the Goal starts running.
+	Start(m *Mob)
+	// Stop is called when the Goal stops running, either because it can no longer continue or because a goal
+	// with a higher priority took over one of its controls.
+	Stop(m *Mob)
+	// Tick is called every tick while the Goal is running.
+	Tick(m *Mob)
+}
+
+// GoalFlag is a set of controls of a Mob that a Goal may use.
+type GoalFlag uint8
+
+const (
+	// GoalFlagMove is set by goals that control the movement of a Mob.
+	GoalFlagMove GoalFlag = 1 << iota
+	// GoalFlagLook is set by goals that control where a Mob looks.
+	GoalFlagLook
+	// GoalFlagJump is set by goals that make a Mob jump or swim.
+	GoalFlagJump
+	// GoalFlagTarget is set by goals that select the target of a Mob.
+	GoalFlagTarget
+)
+
+// GoalSelector holds the goals of a Mob and decides which of them run every tick. Goals with a lower priority
+// value take precedence over goals with a higher priority value.
+type GoalSelector struct {
+	m *Mob
+
+	mu      sync.Mutex
+	goals   []*prioritisedGoal
+	removed []*prioritisedGoal
+}
+
+// prioritisedGoal is a Goal added to a GoalSelector with a priority.
+type prioritisedGoal struct {
+	Goal
+	priority int
+	running  atomic.Bool
+}
+
+// newGoalSelector creates a GoalSelector for the Mob passed.
+func newGoalSelector(m *Mob) *GoalSelector {
+	return &GoalSelector{m: m}
+}
+
+// Add adds a Goal to the GoalSelector with the priority passed. Goals with a lower priority value take
+// precedence over goals with a higher priority value. The Goal passed must not be shared with other mobs.
+func (s *GoalSelector) Add(priority int, g Goal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.goals = append(s.goals, &prioritisedGoal{Goal: g, priority: priority})
+	sort.SliceStable(s.goals, func(i, j int) bool {
+		return s.goals[i].priority < s.goals[j].priority
+	})
+}
+
+// Remove removes a Goal from the GoalSelector. If the Goal is currently running, it is stopped during the next
+// tick of the Mob.
+func (s *GoalSelector) Remove(g Goal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, pg := range s.goals {
+		if pg.Goal == g {
+			s.goals = append(s.goals[:i:i], s.goals[i+1:]...)
+			s.removed = append(s.removed, pg)
+			return
+		}
+	}
+}
+
+// Goals returns all goals in the GoalSelector, ordered by their priority.
+func (s *GoalSelector) Goals() []Goal {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	goals := make([]Goal, 0, len(s.goals))
+	for _, pg := range s.goals {
+		goals = append(goals, pg.Goal)
+	}
+	return goals
+}
+
+// Running returns all goals in the GoalSelector that are currently running.
+func (s *GoalSelector) Running() []Goal {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var goals []Goal
+	for _, pg := range s.goals {
+		if pg.running.Load() {
+			goals = append(goals, pg.Goal)
+		}
+	}
+	return goals
+}
+
+// tick stops goals that can no longer continue, starts goals that can start and ticks all goals running.
+func (s *GoalSelector) tick() {
+	s.mu.Lock()
+	goals, removed := append([]*prioritisedGoal(nil), s.goals...), s.removed
+	s.removed = nil
+	s.mu.Unlock()
+
+	for _, pg := range removed {
+		if pg.running.CompareAndSwap(true, false) {
+			pg.Stop(s.m)
+		}
+	}
+	for _, pg := range goals {
+		if pg.running.Load() && !pg.CanContinue(s.m) {
+			pg.running.Store(false)
+			pg.Stop(s.m)
+		}
+	}
+	for _, pg := range goals {
+		if pg.running.Load() || !s.available(goals, pg) || !pg.CanStart(s.m) {
+			continue
+		}
+		for _, other := range goals {
+			if other.running.Load() && other.Flags()&pg.Flags() != 0 {
+				other.running.Store(false)
+				other.Stop(s.m)
+			}
+		}
+		pg.running.Store(true)
+		pg.Start(s.m)
+	}
+	for _, pg := range goals {
+		if pg.running.Load() {
+			pg.Tick(s.m)
+		}
+	}
+}
+
+// available checks if the controls used by the goal passed are either unused or used by running goals with a
+// lower priority.
+func (s *GoalSelector) available(goals []*prioritisedGoal, pg *prioritisedGoal) bool {
+	for _, other := range goals {
+		if other.running.Load() && other.Flags()&pg.Flags() != 0 && other.priority <= pg.priority {
+			return false
+		}
+	}
+	return true
+}
+
+// close stops all goals that are currently running.
+func (s *GoalSelector) close() {
+	s.mu.Lock()
+	goals := append(append([]*prioritisedGoal(nil), s.goals...), s.removed...)
+	s.removed = nil
+	s.mu.Unlock()
+
+	for _, pg := range goals {
+		if pg.running.CompareAndSwap(true, false) {
+			pg.Stop(s.m)
+		}
+	}
+}
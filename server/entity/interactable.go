@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Interactable represents an entity that reacts to a user interacting with it, such as a cow that is milked
+// when a user interacts with it while holding a bucket.
+type Interactable interface {
+	// Interact is called when a user interacts with the entity using the item held in its main hand. Interact
+	// returns true if the interaction was handled by the entity, in which case the item held is not used on the
+	// entity. The UseContext passed may be used to subtract from or damage the item held.
+	Interact(user item.User, ctx *item.UseContext) bool
+}
+
+// Attackable represents an entity that is not Living but reacts to being attacked, such as a boat that breaks
+// when hit.
+type Attackable interface {
+	// Attack is called when the entity is attacked by the attacker passed, holding the item stack passed.
+	Attack(attacker world.Entity, held item.Stack)
+}
@@ -94,7 +94,7 @@ func (i *ItemBehaviour) checkNearby(e *Ent) {
 		}
 		if collector, ok := other.(Collector); ok {
 			// A collector was within range to pick up the entity.
-			i.collect(e, collector)
+			i.collect(e, collector, collector.Collect)
 			return
 		} else if _, ok := other.Type().(ItemType); ok {
 			// Another item entity was in range to merge with.
@@ -130,10 +130,11 @@ func (i *ItemBehaviour) merge(e *Ent, other *Ent) bool {
 	return true
 }
 
-// collect makes a collector collect the item (or at least part of it).
-func (i *ItemBehaviour) collect(e *Ent, collector Collector) {
+// collect makes a collector collect the item (or at least part of it) by calling the collect function passed,
+// which returns the amount of items collected.
+func (i *ItemBehaviour) collect(e *Ent, collector world.Entity, collect func(stack item.Stack) int) {
 	w, pos := e.World(), e.Position()
-	n := collector.Collect(i.i)
+	n := collect(i.i)
 	if n == 0 {
 		return
 	}
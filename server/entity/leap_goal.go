@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// LeapAtTargetGoal is a Goal that makes a Mob leap towards its target when it is close to it, such as a spider
+// jumping at a player.
+type LeapAtTargetGoal struct {
+	height float64
+}
+
+// NewLeapAtTargetGoal creates a LeapAtTargetGoal that makes a Mob leap with the upwards velocity passed.
+func NewLeapAtTargetGoal(height float64) *LeapAtTargetGoal {
+	return &LeapAtTargetGoal{height: height}
+}
+
+// Flags ...
+func (*LeapAtTargetGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagJump
+}
+
+// CanStart ...
+func (*LeapAtTargetGoal) CanStart(m *Mob) bool {
+	target := m.Target()
+	if !validTarget(m, target) || !m.OnGround() {
+		return false
+	}
+	dist := target.Position().Sub(m.Position()).Len()
+	return dist >= 2 && dist <= 4 && rand.Intn(5) == 0
+}
+
+// CanContinue ...
+func (*LeapAtTargetGoal) CanContinue(m *Mob) bool {
+	return !m.OnGround()
+}
+
+// Start ...
+func (g *LeapAtTargetGoal) Start(m *Mob) {
+	diff := m.Target().Position().Sub(m.Position())
+	diff[1] = 0
+	if diff.Len() > mgl64.Epsilon {
+		diff = diff.Normalize().Mul(0.4)
+	}
+	vel := m.Velocity().Mul(0.2).Add(diff)
+	vel[1] = g.height
+	m.SetVelocity(vel)
+}
+
+// Stop ...
+func (*LeapAtTargetGoal) Stop(*Mob) {}
+
+// Tick ...
+func (*LeapAtTargetGoal) Tick(*Mob) {}
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+)
+
+// LookAtPlayerGoal is a Goal that makes a Mob occasionally look at the nearest player around it.
+type LookAtPlayerGoal struct {
+	distance float64
+	target   world.Entity
+	ticks    int
+}
+
+// NewLookAtPlayerGoal creates a LookAtPlayerGoal that makes a Mob look at players at most distance blocks away.
+func NewLookAtPlayerGoal(distance float64) *LookAtPlayerGoal {
+	return &LookAtPlayerGoal{distance: distance}
+}
+
+// Flags ...
+func (*LookAtPlayerGoal) Flags() GoalFlag {
+	return GoalFlagLook
+}
+
+// CanStart ...
+func (g *LookAtPlayerGoal) CanStart(m *Mob) bool {
+	if rand.Float64() >= 0.02 {
+		return false
+	}
+	g.target = nearestEntity(m, g.distance, isPlayer)
+	return g.target != nil
+}
+
+// CanContinue ...
+func (g *LookAtPlayerGoal) CanContinue(m *Mob) bool {
+	return g.ticks > 0 && g.target.World() == m.World() && g.target.Position().Sub(m.Position()).Len() <= g.distance
+}
+
+// Start ...
+func (g *LookAtPlayerGoal) Start(*Mob) {
+	g.ticks = 40 + rand.Intn(40)
+}
+
+// Stop ...
+func (g *LookAtPlayerGoal) Stop(*Mob) {
+	g.target = nil
+}
+
+// Tick ...
+func (g *LookAtPlayerGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.target))
+	g.ticks--
+}
+
+// LookAroundGoal is a Goal that makes a Mob occasionally look around in a random direction.
+type LookAroundGoal struct {
+	dir   mgl64.Vec3
+	ticks int
+}
+
+// NewLookAroundGoal creates a LookAroundGoal.
+func NewLookAroundGoal() *LookAroundGoal {
+	return &LookAroundGoal{}
+}
+
+// Flags ...
+func (*LookAroundGoal) Flags() GoalFlag {
+	return GoalFlagLook
+}
+
+// CanStart ...
+func (*LookAroundGoal) CanStart(*Mob) bool {
+	return rand.Float64() < 0.02
+}
+
+// CanContinue ...
+func (g *LookAroundGoal) CanContinue(*Mob) bool {
+	return g.ticks > 0
+}
+
+// Start ...
+func (g *LookAroundGoal) Start(*Mob) {
+	angle := rand.Float64() * math.Pi * 2
+	g.dir, g.ticks = mgl64.Vec3{math.Cos(angle), 0, math.Sin(angle)}, 20+rand.Intn(20)
+}
+
+// Stop ...
+func (*LookAroundGoal) Stop(*Mob) {}
+
+// Tick ...
+func (g *LookAroundGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(m).Add(g.dir))
+	g.ticks--
+}
+
+// nearestEntity returns the entity nearest to the Mob passed within the distance passed for which the filter
+// returns true, or nil if no such entity was found.
+func nearestEntity(m *Mob, distance float64, filter func(e world.Entity) bool) world.Entity {
+	pos := m.Position()
+	var (
+		nearest     world.Entity
+		nearestDist = math.MaxFloat64
+	)
+	box := cube.Box(-distance, -distance, -distance, distance, distance, distance).Translate(pos)
+	for _, e := range m.World().EntitiesWithin(box, func(e world.Entity) bool { return e == m.Entity() }) {
+		if l, ok := e.(Living); ok && l.Dead() {
+			continue
+		}
+		if !filter(e) {
+			continue
+		}
+		if dist := e.Position().Sub(pos).Len(); dist <= distance && dist < nearestDist {
+			nearest, nearestDist = e, dist
+		}
+	}
+	return nearest
+}
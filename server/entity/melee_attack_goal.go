@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// MeleeAttackGoal is a Goal that makes a Mob walk towards its target and attack it once it is close enough.
+type MeleeAttackGoal struct {
+	speed    float64
+	cooldown int
+	repath   int
+}
+
+// NewMeleeAttackGoal creates a MeleeAttackGoal that makes a Mob chase its target at the multiple of its speed
+// passed.
+func NewMeleeAttackGoal(speed float64) *MeleeAttackGoal {
+	return &MeleeAttackGoal{speed: speed}
+}
+
+// Flags ...
+func (*MeleeAttackGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook
+}
+
+// CanStart ...
+func (*MeleeAttackGoal) CanStart(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// CanContinue ...
+func (g *MeleeAttackGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (g *MeleeAttackGoal) Start(*Mob) {
+	g.cooldown, g.repath = 0, 0
+}
+
+// Stop ...
+func (*MeleeAttackGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *MeleeAttackGoal) Tick(m *Mob) {
+	target := m.Target()
+	pos := target.Position()
+	m.LookAt(EyePosition(target))
+
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		m.Navigate(pos, g.speed)
+	}
+	if g.cooldown--; g.cooldown > 0 {
+		return
+	}
+	reach := m.Type().BBox(m.Entity()).Width()*2 + target.Type().BBox(target).Width()
+	if diff := pos.Sub(m.Position()); diff.Dot(diff) <= reach*reach {
+		g.cooldown = 20
+		m.AttackEntity(target)
+	}
+}
+
+// validTarget checks if the entity passed is a target that the Mob passed is able to attack.
+func validTarget(m *Mob, e world.Entity) bool {
+	if e == nil || e.World() != m.World() {
+		return false
+	}
+	if l, ok := e.(Living); ok && l.Dead() {
+		return false
+	}
+	if g, ok := e.(interface{ GameMode() world.GameMode }); ok && !g.GameMode().AllowsTakingDamage() {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,609 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// minecartMaxSpeed is the maximum speed in blocks per tick that a minecart may reach on rails.
+	minecartMaxSpeed = 0.4
+	// minecartSlopeAcceleration is the acceleration in blocks per tick that a minecart on a sloped rail receives
+	// towards the bottom of the slope.
+	minecartSlopeAcceleration = 0.0078125
+	// minecartBoost is the speed in blocks per tick that a powered rail adds to a minecart riding over it.
+	minecartBoost = 0.06
+)
+
+// Minecart is a vehicle that rides on rails. It keeps its momentum while riding, speeding up when going down
+// slopes and over powered rails. Besides plain minecarts that players may ride, there are minecarts with a
+// chest, with a hopper that collects items and with TNT that explodes when activated.
+type Minecart struct {
+	t world.EntityType
+
+	mc         *MovementComputer
+	passengers *PassengerManager
+	inv        *inventory.Inventory
+	viewers    containerViewers
+
+	mu     sync.Mutex
+	pos    mgl64.Vec3
+	vel    mgl64.Vec3
+	rot    cube.Rotation
+	age    time.Duration
+	rail   cube.Pos
+	onRail bool
+	damage float64
+
+	primed   bool
+	fuse     time.Duration
+	disabled bool
+}
+
+// NewMinecart creates a new plain Minecart at the position passed, which players may ride.
+func NewMinecart(pos mgl64.Vec3) *Minecart {
+	m := newMinecart(MinecartType{}, pos)
+	m.passengers = NewPassengerManager(mgl64.Vec3{0, 0.35, 0})
+	return m
+}
+
+// NewChestMinecart creates a new Minecart with a chest at the position passed, which holds 27 stacks of items.
+func NewChestMinecart(pos mgl64.Vec3) *Minecart {
+	m := newMinecart(ChestMinecartType{}, pos)
+	m.inv = m.viewers.newInventory(27)
+	return m
+}
+
+// NewHopperMinecart creates a new Minecart with a hopper at the position passed. It collects items lying on the
+// ground around it into its 5 slots, unless it passed over a powered activator rail.
+func NewHopperMinecart(pos mgl64.Vec3) *Minecart {
+	m := newMinecart(HopperMinecartType{}, pos)
+	m.inv = m.viewers.newInventory(5)
+	return m
+}
+
+// NewTNTMinecart creates a new Minecart with TNT at the position passed. It is primed when it passes over a
+// powered activator rail and explodes once its fuse runs out.
+func NewTNTMinecart(pos mgl64.Vec3) *Minecart {
+	return newMinecart(TNTMinecartType{}, pos)
+}
+
+// newMinecart creates a new Minecart of the type passed without passenger seats or inventory.
+func newMinecart(t world.EntityType, pos mgl64.Vec3) *Minecart {
+	return &Minecart{
+		t:          t,
+		pos:        pos,
+		mc:         &MovementComputer{Gravity: 0.04, Drag: 0.05},
+		passengers: NewPassengerManager(),
+	}
+}
+
+// Type returns the world.EntityType of the minecart, which differs for minecarts with a chest, hopper or TNT.
+func (m *Minecart) Type() world.EntityType {
+	return m.t
+}
+
+// Position ...
+func (m *Minecart) Position() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.pos
+}
+
+// Velocity ...
+func (m *Minecart) Velocity() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.vel
+}
+
+// SetVelocity ...
+func (m *Minecart) SetVelocity(v mgl64.Vec3) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vel = v
+}
+
+// Rotation ...
+func (m *Minecart) Rotation() cube.Rotation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rot
+}
+
+// World ...
+func (m *Minecart) World() *world.World {
+	w, _ := world.OfEntity(m)
+	return w
+}
+
+// Age returns the total time lived of the minecart.
+func (m *Minecart) Age() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.age
+}
+
+// Rail returns the position of the rail that the minecart is riding on. False is returned if the minecart is not
+// on a rail.
+func (m *Minecart) Rail() (cube.Pos, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rail, m.onRail
+}
+
+// Inventory returns the inventory of a minecart with a chest or hopper. Nil is returned for other minecarts.
+func (m *Minecart) Inventory() *inventory.Inventory {
+	return m.inv
+}
+
+// AddViewer adds a viewer to the inventory of the minecart, so that it is updated whenever the inventory is
+// changed.
+func (m *Minecart) AddViewer(v ContainerViewer) {
+	m.viewers.add(v)
+}
+
+// RemoveViewer removes a viewer from the inventory of the minecart, so that changes in the inventory are no longer
+// sent to it.
+func (m *Minecart) RemoveViewer(v ContainerViewer) {
+	m.viewers.remove(v)
+}
+
+// Primed returns the time left until a primed TNT minecart explodes. False is returned if the minecart is not
+// primed.
+func (m *Minecart) Primed() (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fuse, m.primed
+}
+
+// Prime primes a TNT minecart, so that it explodes after the fuse passed. Prime does nothing for other minecarts
+// or if the minecart was already primed.
+func (m *Minecart) Prime(fuse time.Duration) {
+	if _, ok := m.t.(TNTMinecartType); !ok {
+		return
+	}
+	m.mu.Lock()
+	if m.primed {
+		m.mu.Unlock()
+		return
+	}
+	m.primed, m.fuse = true, fuse
+	m.mu.Unlock()
+
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityState(m)
+	}
+}
+
+// collectItems makes a hopper minecart collect the items lying on the ground around it into its inventory. Items
+// are not collected if the minecart was disabled by a powered activator rail.
+func (m *Minecart) collectItems(w *world.World, pos mgl64.Vec3) {
+	m.mu.Lock()
+	disabled := m.disabled
+	m.mu.Unlock()
+	if disabled {
+		return
+	}
+	for _, e := range w.EntitiesWithin(m.t.BBox(m).Translate(pos).GrowVec3(mgl64.Vec3{0.25, 0.5, 0.25}), nil) {
+		ent, ok := e.(*Ent)
+		if !ok {
+			continue
+		}
+		if b, ok := ent.Behaviour().(*ItemBehaviour); ok && b.pickupDelay == 0 {
+			b.collect(ent, m, func(stack item.Stack) int {
+				n, _ := m.inv.AddItem(stack)
+				return n
+			})
+		}
+	}
+}
+
+// Passengers ...
+func (m *Minecart) Passengers() []world.Rider {
+	return m.passengers.Passengers()
+}
+
+// Mount makes the rider passed ride the minecart. Only plain minecarts have a seat. False is returned if the
+// seat is taken.
+func (m *Minecart) Mount(r world.Rider) bool {
+	return m.passengers.Mount(m, r)
+}
+
+// Dismount ...
+func (m *Minecart) Dismount(r world.Rider) {
+	m.passengers.Dismount(m, r)
+}
+
+// SeatOffset ...
+func (m *Minecart) SeatOffset(r world.Rider) (mgl64.Vec3, bool) {
+	return m.passengers.SeatOffset(r)
+}
+
+// Interact makes the user ride a plain minecart, or opens the inventory of a minecart with a chest or hopper.
+func (m *Minecart) Interact(user item.User, _ *item.UseContext) bool {
+	if m.inv != nil {
+		if o, ok := user.(containerOpener); ok {
+			o.OpenEntityContainer(m)
+			return true
+		}
+		return false
+	}
+	if r, ok := user.(world.Rider); ok {
+		return m.Mount(r)
+	}
+	return false
+}
+
+// Attack damages the minecart. The minecart breaks and drops its item once it has taken enough damage in a short
+// time, or right away if attacked by a player in creative mode.
+func (m *Minecart) Attack(attacker world.Entity, held item.Stack) {
+	if g, ok := attacker.(interface{ GameMode() world.GameMode }); ok && g.GameMode().CreativeInventory() {
+		m.destroy(false)
+		return
+	}
+	m.mu.Lock()
+	m.damage += held.AttackDamage() * 10
+	broken := m.damage > 40
+	m.mu.Unlock()
+
+	if broken {
+		m.destroy(true)
+		return
+	}
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m, HurtAction{})
+	}
+}
+
+// Explode breaks the minecart, dropping its item. A TNT minecart is primed with a short fuse instead.
+func (m *Minecart) Explode(mgl64.Vec3, float64, block.ExplosionConfig) {
+	if _, ok := m.t.(TNTMinecartType); ok {
+		m.Prime(time.Duration(rand.Intn(10)+10) * time.Second / 20)
+		return
+	}
+	m.destroy(true)
+}
+
+// destroy removes the minecart from the world. If drop is true, the minecart item and the contents of its
+// inventory are dropped.
+func (m *Minecart) destroy(drop bool) {
+	w, pos := m.World(), m.Position()
+	if w == nil {
+		return
+	}
+	if drop {
+		var it world.Item = block.Minecart{}
+		switch m.t.(type) {
+		case ChestMinecartType:
+			it = block.ChestMinecart{}
+		case HopperMinecartType:
+			it = block.HopperMinecart{}
+		case TNTMinecartType:
+			it = block.TNTMinecart{}
+		}
+		drops := []item.Stack{item.NewStack(it, 1)}
+		if m.inv != nil {
+			drops = append(drops, m.inv.Clear()...)
+		}
+		for _, s := range drops {
+			i := NewItem(s, pos.Add(mgl64.Vec3{0, 0.5}))
+			i.vel = mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1}
+			w.AddEntity(i)
+		}
+	}
+	_ = m.Close()
+}
+
+// Tick moves the minecart along the rail it is on, or lets it fall and slide if it is not on a rail.
+func (m *Minecart) Tick(w *world.World, current int64) {
+	m.mu.Lock()
+	pos, vel, rot := m.pos, m.vel, m.rot
+	m.damage = max(m.damage-1, 0)
+	m.age += time.Second / 20
+	exploded := m.primed && m.fuse <= 0
+	if m.primed {
+		m.fuse -= time.Second / 20
+	}
+	m.mu.Unlock()
+
+	if pos[1] < float64(w.Range()[0]) && current%10 == 0 {
+		_ = m.Close()
+		return
+	}
+	if exploded {
+		_ = m.Close()
+		block.ExplosionConfig{Size: 4}.Explode(w, pos)
+		return
+	}
+	vel = m.pushByEntities(w, pos, vel)
+
+	var mv *Movement
+	railPos, track, onRail := minecartTrack(w, pos)
+	if onRail {
+		mv = m.moveOnTrack(w, railPos, track, pos, vel, rot)
+	} else {
+		mv = m.mc.TickMovement(m, pos, vel, rot)
+	}
+
+	m.mu.Lock()
+	m.pos, m.vel, m.rot = mv.pos, mv.vel, mv.rot
+	m.rail, m.onRail = railPos, onRail
+	m.mu.Unlock()
+
+	mv.Send()
+	if onRail {
+		m.activateTrack(w, railPos, track)
+	}
+	if _, ok := m.t.(HopperMinecartType); ok {
+		m.collectItems(w, mv.pos)
+	}
+	m.passengers.Tick(m)
+}
+
+// moveOnTrack moves the minecart along the track at the position passed. The minecart keeps its speed, which is
+// redirected along the track, and speeds up down slopes and over powered rails.
+func (m *Minecart) moveOnTrack(w *world.World, railPos cube.Pos, track block.Track, pos, vel mgl64.Vec3, rot cube.Rotation) *Movement {
+	before, shape := vel, track.RailShape()
+	ends := shape.Connections()
+	start, end := railPos.Vec3Middle().Add(directionVec(ends[0]).Mul(0.5)), railPos.Vec3Middle().Add(directionVec(ends[1]).Mul(0.5))
+	dir := end.Sub(start).Normalize()
+
+	if up, ok := shape.Ascending(); ok {
+		vel = vel.Sub(directionVec(up).Mul(minecartSlopeAcceleration))
+	}
+	speed := math.Hypot(vel[0], vel[2])
+	if vel.Dot(dir) < 0 {
+		dir = dir.Mul(-1)
+	}
+	if r, ok := track.(block.PoweredRail); ok {
+		if !r.Powered {
+			if speed *= 0.5; speed < 0.03 {
+				speed = 0
+			}
+		} else if speed > 0.01 {
+			speed += minecartBoost
+		} else {
+			// A stationary minecart on a powered rail is pushed away from a solid block at either end of the rail.
+			for i, d := range ends {
+				side := railPos.Side(d.Face())
+				if w.Block(side).Model().FaceSolid(side, d.Face().Opposite(), w) {
+					dir, speed = directionVec(ends[1-i]), 0.02
+					break
+				}
+			}
+		}
+	}
+	drag := 0.96
+	if len(m.passengers.Passengers()) > 0 {
+		drag = 0.997
+	}
+	speed = min(speed, minecartMaxSpeed) * drag
+	vel = mgl64.Vec3{dir[0] * speed, 0, dir[2] * speed}
+
+	// Snap the minecart onto the line of the track before moving it along it, so that it follows curves. The
+	// minecart may move past the end of the track into the next block, but its height stays within the slope.
+	snapped := trackPosition(railPos, shape, start, end, pos)
+	newPos := snapped.Add(vel)
+	newPos[1] = trackPosition(railPos, shape, start, end, newPos)[1]
+	if minecartBlocked(w, newPos) {
+		newPos, vel = snapped, mgl64.Vec3{}
+	}
+	if speed > 0.001 {
+		rot = cube.Rotation{mgl64.RadToDeg(math.Atan2(-dir[0], dir[2])), 0}
+	}
+	return &Movement{v: w.Viewers(pos), e: m,
+		pos: newPos, vel: vel, dpos: newPos.Sub(pos), dvel: vel.Sub(before),
+		rot: rot, onGround: true,
+	}
+}
+
+// activateTrack lets the track at the position passed act on the minecart. Detector rails are powered by it and
+// powered activator rails eject its riders, prime TNT and disable hoppers.
+func (m *Minecart) activateTrack(w *world.World, railPos cube.Pos, track block.Track) {
+	if insider, ok := track.(block.EntityInsider); ok {
+		insider.EntityInside(railPos, w, m)
+	}
+	r, ok := track.(block.ActivatorRail)
+	if !ok {
+		return
+	}
+	switch m.t.(type) {
+	case MinecartType:
+		if r.Powered {
+			m.passengers.DismountAll(m)
+		}
+	case TNTMinecartType:
+		if r.Powered {
+			m.Prime(time.Second * 4)
+		}
+	case HopperMinecartType:
+		m.mu.Lock()
+		m.disabled = r.Powered
+		m.mu.Unlock()
+	}
+}
+
+// pushByEntities pushes the minecart away from living entities, such as players walking into it, that are
+// colliding with it.
+func (m *Minecart) pushByEntities(w *world.World, pos, vel mgl64.Vec3) mgl64.Vec3 {
+	passengers := m.passengers.Passengers()
+	box := m.t.BBox(m).Translate(pos)
+	for _, e := range w.EntitiesWithin(box, func(e world.Entity) bool {
+		_, living := e.(Living)
+		r, rider := e.(world.Rider)
+		return !living || (rider && slices.Contains(passengers, r))
+	}) {
+		d := pos.Sub(e.Position())
+		d[1] = 0
+		if d.Len() > 0.01 {
+			vel = vel.Add(d.Normalize().Mul(0.02))
+		}
+	}
+	return vel
+}
+
+// Close removes the minecart from the world after dismounting its rider and closing its inventory for all of its
+// viewers.
+func (m *Minecart) Close() error {
+	m.passengers.DismountAll(m)
+	m.viewers.closeAll(m)
+	if w := m.World(); w != nil {
+		w.RemoveEntity(m)
+	}
+	return nil
+}
+
+// minecartTrack returns the track that a minecart at the position passed rides on. Tracks directly below the
+// minecart are also returned, so that minecarts follow rails going down slopes.
+func minecartTrack(w *world.World, pos mgl64.Vec3) (cube.Pos, block.Track, bool) {
+	p := cube.PosFromVec3(pos)
+	for _, railPos := range []cube.Pos{p, p.Side(cube.FaceDown)} {
+		if t, ok := w.Block(railPos).(block.Track); ok {
+			return railPos, t, true
+		}
+	}
+	return cube.Pos{}, nil, false
+}
+
+// trackPosition projects the position passed onto the line of the track of the shape passed at railPos, which
+// runs from start to end. The height of the position follows the slope of ascending tracks.
+func trackPosition(railPos cube.Pos, shape block.RailShape, start, end, pos mgl64.Vec3) mgl64.Vec3 {
+	line := end.Sub(start)
+	progress := mgl64.Clamp(pos.Sub(start).Dot(line)/line.Dot(line), 0, 1)
+	p := start.Add(line.Mul(progress))
+	p[1] = float64(railPos[1])
+	if up, ok := shape.Ascending(); ok {
+		if up == shape.Connections()[1] {
+			p[1] += progress
+		} else {
+			p[1] += 1 - progress
+		}
+	}
+	return p
+}
+
+// minecartBlocked checks if a minecart at the position passed would run into a block that is not a track.
+func minecartBlocked(w *world.World, pos mgl64.Vec3) bool {
+	p := cube.PosFromVec3(pos.Add(mgl64.Vec3{0, 0.1}))
+	b := w.Block(p)
+	if _, ok := b.(block.Track); ok {
+		return false
+	}
+	return len(b.Model().BBox(p, w)) > 0
+}
+
+// directionVec returns a horizontal unit vector pointing in the direction passed.
+func directionVec(d cube.Direction) mgl64.Vec3 {
+	p := cube.Pos{}.Side(d.Face())
+	return mgl64.Vec3{float64(p[0]), 0, float64(p[2])}
+}
+
+// minecartBBox is the bounding box of all minecarts.
+var minecartBBox = cube.Box(-0.49, 0, -0.49, 0.49, 0.7, 0.49)
+
+// MinecartType is a world.EntityType implementation for plain Minecarts.
+type MinecartType struct{}
+
+func (MinecartType) EncodeEntity() string        { return "minecraft:minecart" }
+func (MinecartType) NetworkOffset() float64      { return 0.35 }
+func (MinecartType) BBox(world.Entity) cube.BBox { return minecartBBox }
+
+func (MinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecartNBT(NewMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (MinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecartNBT(e.(*Minecart))
+}
+
+// ChestMinecartType is a world.EntityType implementation for Minecarts with a chest.
+type ChestMinecartType struct{}
+
+func (ChestMinecartType) EncodeEntity() string        { return "minecraft:chest_minecart" }
+func (ChestMinecartType) NetworkOffset() float64      { return 0.35 }
+func (ChestMinecartType) BBox(world.Entity) cube.BBox { return minecartBBox }
+
+func (ChestMinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecartNBT(NewChestMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (ChestMinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecartNBT(e.(*Minecart))
+}
+
+// HopperMinecartType is a world.EntityType implementation for Minecarts with a hopper.
+type HopperMinecartType struct{}
+
+func (HopperMinecartType) EncodeEntity() string        { return "minecraft:hopper_minecart" }
+func (HopperMinecartType) NetworkOffset() float64      { return 0.35 }
+func (HopperMinecartType) BBox(world.Entity) cube.BBox { return minecartBBox }
+
+func (HopperMinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecartNBT(NewHopperMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (HopperMinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecartNBT(e.(*Minecart))
+}
+
+// TNTMinecartType is a world.EntityType implementation for Minecarts with TNT.
+type TNTMinecartType struct{}
+
+func (TNTMinecartType) EncodeEntity() string        { return "minecraft:tnt_minecart" }
+func (TNTMinecartType) NetworkOffset() float64      { return 0.35 }
+func (TNTMinecartType) BBox(world.Entity) cube.BBox { return minecartBBox }
+
+func (TNTMinecartType) DecodeNBT(m map[string]any) world.Entity {
+	return decodeMinecartNBT(NewTNTMinecart(nbtconv.Vec3(m, "Pos")), m)
+}
+
+func (TNTMinecartType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMinecartNBT(e.(*Minecart))
+}
+
+// decodeMinecartNBT decodes the properties shared by all minecarts from the NBT data passed into the minecart m.
+func decodeMinecartNBT(m *Minecart, data map[string]any) *Minecart {
+	m.vel, m.rot = nbtconv.Vec3(data, "Motion"), nbtconv.Rotation(data)
+	if m.inv != nil {
+		nbtconv.InvFromNBT(m.inv, nbtconv.Slice(data, "Items"))
+	}
+	if _, ok := data["Fuse"]; ok {
+		m.primed, m.fuse = true, nbtconv.TickDuration[uint8](data, "Fuse")
+	}
+	m.disabled = nbtconv.Bool(data, "Disabled")
+	return m
+}
+
+// encodeMinecartNBT encodes the minecart passed to a map that can be encoded to NBT.
+func encodeMinecartNBT(m *Minecart) map[string]any {
+	yaw, pitch := m.Rotation().Elem()
+	data := map[string]any{
+		"Pos":    nbtconv.Vec3ToFloat32Slice(m.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(m.Velocity()),
+		"Yaw":    float32(yaw),
+		"Pitch":  float32(pitch),
+	}
+	if m.inv != nil {
+		data["Items"] = nbtconv.InvToNBT(m.inv)
+	}
+	if fuse, primed := m.Primed(); primed {
+		data["Fuse"] = uint8(fuse.Milliseconds() / 50)
+	}
+	if _, ok := m.t.(HopperMinecartType); ok {
+		m.mu.Lock()
+		data["Disabled"] = m.disabled
+		m.mu.Unlock()
+	}
+	return data
+}
@@ -0,0 +1,1050 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube/trace"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/effect"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/enchantment"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// MobConfig holds the parameters used to create a Mob. Custom mobs may be composed by creating a Mob with a
+// MobConfig and adding goals to its goal and target selectors.
+type MobConfig struct {
+	// MaxHealth is the maximum health of the mob. If 0, a maximum health of 20 is used.
+	MaxHealth float64
+	// Speed is the movement speed of the mob. Mobs move at a fraction of this speed every tick, depending on the
+	// ground they walk on. If 0, a speed of 0.25 is used.
+	Speed float64
+	// AttackDamage is the damage dealt by the mob when it attacks another entity. If 0, a damage of 2 is used.
+	AttackDamage float64
+	// EyeHeight is the offset from the position of the mob that its eyes are found at.
+	EyeHeight float64
+	// DisableFallDamage specifies if the mob should not take damage when falling, such as a chicken that slowly
+	// flaps its wings to break its fall.
+	DisableFallDamage bool
+	// Drops returns the items dropped by the mob when it is killed by the damage source passed.
+	Drops func(m *Mob, src world.DamageSource) []item.Stack
+	// Experience returns the amount of experience dropped by the mob when it is killed by a player.
+	Experience func(m *Mob) int
+	// EquipmentDropChance is the chance, between 0 and 1, that each item held or worn by the mob is dropped
+	// when it is killed by a player.
+	EquipmentDropChance float64
+	// Climbs specifies if the mob is able to climb up walls that it walks into, such as a spider.
+	Climbs bool
+	// Pathfinder is used to find the paths that the mob follows when navigating. If nil, a Pathfinder is created
+	// for the size of the mob that is able to swim. A Pathfinder keeps the paths it found, and the worlds they
+	// were found in, cached, so a Pathfinder should generally not outlive the mobs that use it.
+	Pathfinder *pathfind.Pathfinder
+	// Tick is called for every tick that the mob is alive. Tick is called after the goals of the mob are ticked
+	// and after the mob moves.
+	Tick func(m *Mob)
+}
+
+// New creates a new Mob using conf. The mob has a type and a position.
+func (conf MobConfig) New(t world.EntityType, pos mgl64.Vec3) *Mob {
+	return conf.new(t, pos, nil)
+}
+
+// new creates a new Mob using conf. If self is not nil, it is the entity that embeds the Mob and that is added
+// to worlds and shown to viewers in place of the Mob itself.
+func (conf MobConfig) new(t world.EntityType, pos mgl64.Vec3, self world.Entity) *Mob {
+	if conf.MaxHealth == 0 {
+		conf.MaxHealth = 20
+	}
+	if conf.Speed == 0 {
+		conf.Speed = 0.25
+	}
+	if conf.AttackDamage == 0 {
+		conf.AttackDamage = 2
+	}
+	m := &Mob{
+		conf:    conf,
+		t:       t,
+		pos:     pos,
+		speed:   conf.Speed,
+		health:  NewHealthManager(conf.MaxHealth, conf.MaxHealth),
+		effects: NewEffectManager(),
+		mc:      &MovementComputer{Gravity: 0.08, Drag: 0.02, DragBeforeGravity: true},
+	}
+	if m.self = self; self == nil {
+		m.self = m
+	}
+	m.armour = inventory.NewArmour(func(int, item.Stack, item.Stack) {
+		if w := m.World(); w != nil {
+			for _, v := range w.Viewers(m.Position()) {
+				v.ViewEntityArmour(m.self)
+			}
+		}
+	})
+	if m.pathfinder = conf.Pathfinder; m.pathfinder == nil {
+		bb := t.BBox(m)
+		m.pathfinder = pathfind.Config{Width: bb.Width(), Height: bb.Height(), CanSwim: true}.New()
+	}
+	m.goals, m.targets = newGoalSelector(m), newGoalSelector(m)
+	return m
+}
+
+// Mob is a world.Entity implementation for living entities that act on their own, such as animals and monsters.
+// The behaviour of a Mob is driven by the goals added to its goal selector, which control its movement and where
+// it looks, and its target selector, which controls the entity it targets.
+type Mob struct {
+	conf MobConfig
+	t    world.EntityType
+	self world.Entity
+
+	mu  sync.Mutex
+	pos mgl64.Vec3
+	vel mgl64.Vec3
+	rot cube.Rotation
+
+	name string
+
+	fireDuration time.Duration
+	age          time.Duration
+	immunity     time.Duration
+	speed        float64
+	fallDistance float64
+	stepDistance float64
+	deathTicks   int
+
+	mainHand, offHand item.Stack
+
+	target, owner, lastAttacker world.Entity
+	lastHurt                    time.Duration
+	hurt                        bool
+
+	path      *pathfind.Path
+	dest      mgl64.Vec3
+	navSpeed  float64
+	navTicks  int
+	navigate  bool
+	move      mgl64.Vec3
+	moving    bool
+	steering  bool
+	look      mgl64.Vec3
+	looking   bool
+	jumping   bool
+	collided  bool
+	submerged bool
+
+	mc         *MovementComputer
+	pathfinder *pathfind.Pathfinder
+	health     *HealthManager
+	effects    *EffectManager
+	armour     *inventory.Armour
+
+	goals, targets *GoalSelector
+}
+
+// Type returns the world.EntityType passed to MobConfig.New.
+func (m *Mob) Type() world.EntityType {
+	return m.t
+}
+
+// Entity returns the world.Entity that the mob is part of. This is the Mob itself, unless it is embedded in
+// another entity, such as a Cow, in which case that entity is returned.
+func (m *Mob) Entity() world.Entity {
+	return m.self
+}
+
+// Position returns the current position of the mob.
+func (m *Mob) Position() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.pos
+}
+
+// Velocity returns the current velocity of the mob. The values in the Vec3 returned represent the speed on
+// that axis in blocks/tick.
+func (m *Mob) Velocity() mgl64.Vec3 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.vel
+}
+
+// SetVelocity sets the velocity of the mob. The values in the Vec3 passed represent the speed on
+// that axis in blocks/tick.
+func (m *Mob) SetVelocity(v mgl64.Vec3) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.vel = v
+}
+
+// Rotation returns the rotation of the mob.
+func (m *Mob) Rotation() cube.Rotation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.rot
+}
+
+// Teleport teleports the mob to the position passed.
+func (m *Mob) Teleport(pos mgl64.Vec3) {
+	m.mu.Lock()
+	m.pos, m.vel, m.fallDistance = pos, mgl64.Vec3{}, 0
+	m.mu.Unlock()
+
+	for _, v := range m.World().Viewers(pos) {
+		v.ViewEntityTeleport(m.self, pos)
+	}
+}
+
+// World returns the world of the mob.
+func (m *Mob) World() *world.World {
+	w, _ := world.OfEntity(m.self)
+	return w
+}
+
+// Age returns the total time lived of this mob. It increases by time.Second/20 for every time Tick is called.
+func (m *Mob) Age() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.age
+}
+
+// EyeHeight returns the offset from the position of the mob that its eyes are found at.
+func (m *Mob) EyeHeight() float64 {
+	return m.conf.EyeHeight
+}
+
+// OnGround checks if the mob is currently on the ground.
+func (m *Mob) OnGround() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mc.OnGround()
+}
+
+// OnFireDuration ...
+func (m *Mob) OnFireDuration() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fireDuration
+}
+
+// SetOnFire ...
+func (m *Mob) SetOnFire(duration time.Duration) {
+	if _, ok := m.Effect(effect.FireResistance{}); ok && duration > 0 {
+		return
+	}
+	duration = max(duration, 0)
+	m.mu.Lock()
+	before, after := m.fireDuration > 0, duration > 0
+	m.fireDuration = duration
+	pos := m.pos
+	m.mu.Unlock()
+
+	if before != after {
+		m.viewState(pos)
+	}
+}
+
+// Extinguish ...
+func (m *Mob) Extinguish() {
+	m.SetOnFire(0)
+}
+
+// NameTag returns the name tag of the mob. An empty string is returned if no name tag was set.
+func (m *Mob) NameTag() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.name
+}
+
+// Persistent checks if the mob is never despawned when far away from players. This is the case if the mob has a
+// name tag.
+func (m *Mob) Persistent() bool {
+	return m.NameTag() != ""
+}
+
+// SetNameTag changes the name tag of the mob. The name tag is removed if an empty string is passed.
+func (m *Mob) SetNameTag(s string) {
+	m.mu.Lock()
+	m.name = s
+	m.mu.Unlock()
+
+	m.viewState(m.Position())
+}
+
+// HeldItems returns the items currently held by the mob in its main hand and off-hand.
+func (m *Mob) HeldItems() (mainHand, offHand item.Stack) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mainHand, m.offHand
+}
+
+// SetHeldItems sets the items held by the mob in its main hand and off-hand.
+func (m *Mob) SetHeldItems(mainHand, offHand item.Stack) {
+	m.mu.Lock()
+	m.mainHand, m.offHand = mainHand, offHand
+	m.mu.Unlock()
+
+	if w := m.World(); w != nil {
+		for _, v := range w.Viewers(m.Position()) {
+			v.ViewEntityItems(m.self)
+		}
+	}
+}
+
+// Armour returns the armour inventory of the mob.
+func (m *Mob) Armour() *inventory.Armour {
+	return m.armour
+}
+
+// Health returns the current health of the mob.
+func (m *Mob) Health() float64 {
+	return m.health.Health()
+}
+
+// MaxHealth returns the maximum health of the mob.
+func (m *Mob) MaxHealth() float64 {
+	return m.health.MaxHealth()
+}
+
+// SetMaxHealth sets the maximum health of the mob. If the current health of the mob is higher than the new
+// maximum health, the health is set to the new maximum.
+func (m *Mob) SetMaxHealth(health float64) {
+	m.health.SetMaxHealth(health)
+}
+
+// Dead checks if the mob is considered dead. True is returned if the health of the mob is equal to or lower
+// than 0.
+func (m *Mob) Dead() bool {
+	return m.Health() <= mgl64.Epsilon
+}
+
+// Speed returns the current movement speed of the mob.
+func (m *Mob) Speed() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.speed
+}
+
+// SetSpeed sets the movement speed of the mob.
+func (m *Mob) SetSpeed(speed float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.speed = speed
+}
+
+// AttackImmune checks if the mob is currently immune to entity attacks, meaning it was recently attacked.
+func (m *Mob) AttackImmune() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.immunity > 0
+}
+
+// SetAttackImmunity sets the duration the mob is immune to entity attacks.
+func (m *Mob) SetAttackImmunity(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.immunity = d
+}
+
+// Heal heals the mob for a given amount of health. If the health passed is negative, Heal will not do
+// anything.
+func (m *Mob) Heal(health float64, _ world.HealingSource) {
+	if m.Dead() || health < 0 {
+		return
+	}
+	m.health.AddHealth(health)
+}
+
+// Hurt hurts the mob for a given amount of damage. The source passed represents the cause of the damage, for
+// example AttackDamageSource if the mob is attacked by another entity. If the final damage exceeds the health
+// that the mob currently has, the mob is killed.
+// If the damage passed is negative, Hurt will not do anything. Hurt returns the final damage dealt to the Mob
+// and if the Mob was vulnerable to this kind of damage.
+func (m *Mob) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
+	if _, ok := m.Effect(effect.FireResistance{}); (ok && src.Fire()) || m.Dead() {
+		return 0, false
+	}
+	if dmg < 0 {
+		return 0, true
+	}
+	totalDamage := m.FinalDamageFrom(dmg, src)
+	m.health.AddHealth(-totalDamage)
+
+	origin := damageOrigin(src)
+	if src.ReducedByArmour() {
+		m.armour.Damage(dmg, m.damageItem)
+		if l, ok := origin.(Living); ok {
+			if thornsDmg := m.armour.ThornsDamage(m.damageItem); thornsDmg > 0 {
+				l.Hurt(thornsDmg, enchantment.ThornsDamageSource{Owner: m.self})
+			}
+		}
+	}
+	m.mu.Lock()
+	if origin != nil && origin != m.self {
+		m.lastAttacker = origin
+	}
+	m.lastHurt, m.hurt, m.immunity = m.age, true, time.Second/2
+	m.mu.Unlock()
+
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m.self, HurtAction{})
+	}
+	if m.Dead() {
+		m.kill(src)
+	}
+	return totalDamage, true
+}
+
+// damageOrigin returns the entity that caused the damage source passed, or nil if the damage was not caused by
+// an entity.
+func damageOrigin(src world.DamageSource) world.Entity {
+	switch s := src.(type) {
+	case AttackDamageSource:
+		return s.Attacker
+	case ProjectileDamageSource:
+		return s.Owner
+	}
+	return nil
+}
+
+// FinalDamageFrom resolves the final damage received by the mob if it is attacked by the source passed with
+// the damage passed. FinalDamageFrom takes into account the armour worn by the mob and its effects.
+func (m *Mob) FinalDamageFrom(dmg float64, src world.DamageSource) float64 {
+	dmg = math.Max(dmg, 0)
+
+	dmg -= m.armour.DamageReduction(dmg, src)
+	if res, ok := m.Effect(effect.Resistance{}); ok {
+		dmg *= effect.Resistance{}.Multiplier(src, res.Level())
+	}
+	return dmg
+}
+
+// damageItem damages the item stack passed with the damage passed and returns the new stack.
+func (m *Mob) damageItem(s item.Stack, d int) item.Stack {
+	if d == 0 || s.MaxDurability() == -1 {
+		return s
+	}
+	if e, ok := s.Enchantment(enchantment.Unbreaking{}); ok {
+		d = (enchantment.Unbreaking{}).Reduce(s.Item(), e.Level(), d)
+	}
+	return s.Damage(d)
+}
+
+// KnockBack knocks the mob back with a given force and height. A source is passed which indicates the source
+// of the velocity, typically the position of an attacking entity.
+func (m *Mob) KnockBack(src mgl64.Vec3, force, height float64) {
+	if m.Dead() {
+		return
+	}
+	velocity := m.Position().Sub(src)
+	velocity[1] = 0
+
+	if velocity.Len() != 0 {
+		velocity = velocity.Normalize().Mul(force)
+	}
+	velocity[1] = height
+
+	m.SetVelocity(velocity.Mul(1 - m.armour.KnockBackResistance()))
+}
+
+// Explode ...
+func (m *Mob) Explode(explosionPos mgl64.Vec3, impact float64, c block.ExplosionConfig) {
+	diff := m.Position().Sub(explosionPos)
+	if !c.DisableEntityDamage {
+		m.self.(Living).Hurt(math.Floor((impact*impact+impact)*3.5*c.Size+1), ExplosionDamageSource{})
+	}
+	m.KnockBack(explosionPos, impact, diff[1]/diff.Len()*impact)
+}
+
+// AddEffect adds an entity.Effect to the mob. If the effect is instant, it is applied to the mob immediately.
+// If not, the effect is applied to the mob every time it is ticked.
+func (m *Mob) AddEffect(e effect.Effect) {
+	m.effects.Add(e, m.self.(Living))
+	m.viewState(m.Position())
+}
+
+// RemoveEffect removes any effect that might currently be active on the mob.
+func (m *Mob) RemoveEffect(e effect.Type) {
+	m.effects.Remove(e, m.self.(Living))
+	m.viewState(m.Position())
+}
+
+// Effect returns the effect instance and true if the mob has the effect. If not found, it will return an empty
+// effect instance and false.
+func (m *Mob) Effect(e effect.Type) (effect.Effect, bool) {
+	return m.effects.Effect(e)
+}
+
+// Effects returns any effect currently applied to the mob. The returned effects are guaranteed not to have
+// expired when returned.
+func (m *Mob) Effects() []effect.Effect {
+	return m.effects.Effects()
+}
+
+// FallDistance returns the distance that the mob has currently fallen.
+func (m *Mob) FallDistance() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.fallDistance
+}
+
+// ResetFallDistance resets the distance that the mob has fallen.
+func (m *Mob) ResetFallDistance() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.fallDistance = 0
+}
+
+// Goals returns the GoalSelector that holds the goals controlling the movement and looking of the mob.
+func (m *Mob) Goals() *GoalSelector {
+	return m.goals
+}
+
+// Targets returns the GoalSelector that holds the goals selecting the target of the mob.
+func (m *Mob) Targets() *GoalSelector {
+	return m.targets
+}
+
+// Target returns the entity currently targeted by the mob, or nil if the mob has no target.
+func (m *Mob) Target() world.Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.target
+}
+
+// SetTarget sets the entity targeted by the mob. Passing nil removes the current target.
+func (m *Mob) SetTarget(e world.Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.target = e
+}
+
+// Owner returns the owner of the mob, such as the player that tamed it, or nil if the mob has no owner.
+func (m *Mob) Owner() world.Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.owner
+}
+
+// SetOwner sets the owner of the mob. Passing nil removes the current owner.
+func (m *Mob) SetOwner(e world.Entity) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.owner = e
+}
+
+// LastAttacker returns the entity that last attacked the mob, or nil if the mob has not been attacked by an
+// entity.
+func (m *Mob) LastAttacker() world.Entity {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lastAttacker
+}
+
+// RecentlyHurt checks if the mob was hurt within the duration passed.
+func (m *Mob) RecentlyHurt(d time.Duration) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.hurt && m.age-m.lastHurt <= d
+}
+
+// Navigate makes the mob follow a path to the position passed at a multiple of its movement speed. If the
+// position cannot be reached, the mob moves as close to it as possible. False is returned if no path could be
+// found at all. Navigate must only be called while the world of the mob is being ticked, such as from a Goal.
+func (m *Mob) Navigate(pos mgl64.Vec3, speed float64) bool {
+	w := m.World()
+	if w == nil {
+		return false
+	}
+	path, ok := m.pathfinder.Find(w, m.Position(), pos)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.path, m.dest, m.navSpeed, m.navTicks, m.navigate = path, pos, speed, 0, ok
+	return ok
+}
+
+// Path returns the path that the mob is currently following. False is returned if the mob is not navigating.
+func (m *Mob) Path() (*pathfind.Path, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.path, m.navigate
+}
+
+// Navigating checks if the mob is currently navigating towards a position.
+func (m *Mob) Navigating() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.navigate
+}
+
+// StopNavigating stops the mob from navigating towards the position it is currently navigating to.
+func (m *Mob) StopNavigating() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.navigate, m.path = false, nil
+}
+
+// LookAt makes the mob look at the position passed during the current tick.
+func (m *Mob) LookAt(pos mgl64.Vec3) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.look, m.looking = pos, true
+}
+
+// Steer makes the mob walk in a straight line towards the position passed during the current tick, at the speed
+// passed. Unlike Navigate, Steer does not follow a path, which makes it suitable for mobs steered by a rider.
+// Steer stops the mob from navigating and must be called from a Goal.
+func (m *Mob) Steer(pos mgl64.Vec3, speed float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.navigate, m.path = false, nil
+	m.move, m.navSpeed, m.steering = pos, speed, true
+}
+
+// Jump makes the mob jump during the current tick if it is on the ground.
+func (m *Mob) Jump() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jumping = true
+}
+
+// CanSee checks if the mob is able to see the entity passed, meaning that there are no blocks between the eyes
+// of the mob and the eyes of the entity.
+func (m *Mob) CanSee(e world.Entity) bool {
+	w := m.World()
+	if w == nil || e.World() != w {
+		return false
+	}
+	start, end := EyePosition(m.self), EyePosition(e)
+	visible := true
+	trace.TraverseBlocks(start, end, func(pos cube.Pos) bool {
+		if _, ok := trace.BlockIntercept(pos, w, w.Block(pos), start, end); ok {
+			visible = false
+		}
+		return visible
+	})
+	return visible
+}
+
+// InWater checks if the mob is currently in water.
+func (m *Mob) InWater() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.submerged
+}
+
+// AttackEntity makes the mob attack the entity passed using its attack damage. False is returned if the entity
+// could not be attacked.
+func (m *Mob) AttackEntity(e world.Entity) bool {
+	l, ok := e.(Living)
+	if !ok || l.Dead() || m.Dead() {
+		return false
+	}
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m.self, SwingArmAction{})
+	}
+	if l.AttackImmune() {
+		return false
+	}
+	dmg := m.conf.AttackDamage
+	if held, _ := m.HeldItems(); !held.Empty() {
+		dmg = math.Max(dmg, held.AttackDamage())
+	}
+	if strength, ok := m.Effect(effect.Strength{}); ok {
+		dmg += dmg * effect.Strength{}.Multiplier(strength.Level())
+	}
+	if weakness, ok := m.Effect(effect.Weakness{}); ok {
+		dmg -= dmg * effect.Weakness{}.Multiplier(weakness.Level())
+	}
+	if isPlayer(e) {
+		dmg = world.MobDamage(m.World().Difficulty(), dmg)
+	}
+	if _, vulnerable := l.Hurt(dmg, AttackDamageSource{Attacker: m.self}); !vulnerable {
+		return false
+	}
+	l.KnockBack(m.Position(), 0.4, 0.4)
+	return true
+}
+
+// kill kills the mob, dropping its loot and removing it from the world shortly after.
+func (m *Mob) kill(src world.DamageSource) {
+	w, pos := m.World(), m.Position()
+	for _, v := range w.Viewers(pos) {
+		v.ViewEntityAction(m.self, DeathAction{})
+	}
+	w.EmitGameEvent(pos, world.GameEventEntityDie(), m.self)
+	m.StopNavigating()
+	m.SetTarget(nil)
+	m.dismountPassengers()
+
+	var drops []item.Stack
+	if m.conf.Drops != nil {
+		drops = m.conf.Drops(m, src)
+	}
+	if chance := m.conf.EquipmentDropChance; chance > 0 && m.killedByPlayer() {
+		mainHand, offHand := m.HeldItems()
+		for _, it := range append([]item.Stack{mainHand, offHand}, m.armour.Slots()...) {
+			if !it.Empty() && rand.Float64() < chance {
+				drops = append(drops, it)
+			}
+		}
+	}
+	for _, it := range drops {
+		i := NewItem(it, pos.Add(mgl64.Vec3{0, 0.5}))
+		i.vel = mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1}
+		w.AddEntity(i)
+	}
+	if m.conf.Experience != nil && m.killedByPlayer() {
+		for _, orb := range NewExperienceOrbs(pos, m.conf.Experience(m)) {
+			w.AddEntity(orb)
+		}
+	}
+}
+
+// killedByPlayer checks if the mob was recently attacked by a player.
+func (m *Mob) killedByPlayer() bool {
+	attacker := m.LastAttacker()
+	return attacker != nil && isPlayer(attacker) && m.RecentlyHurt(time.Second*5)
+}
+
+// isPlayer checks if the entity passed is a player.
+func isPlayer(e world.Entity) bool {
+	return e.Type().EncodeEntity() == "minecraft:player"
+}
+
+// Tick ticks the mob, running its goals and moving it.
+func (m *Mob) Tick(w *world.World, current int64) {
+	if m.Dead() {
+		m.mu.Lock()
+		m.deathTicks++
+		ticks := m.deathTicks
+		m.mu.Unlock()
+		if ticks >= 20 {
+			_ = m.Close()
+		}
+		return
+	}
+	if m.Position()[1] < float64(w.Range()[0]) && current%10 == 0 {
+		m.self.(Living).Hurt(4, VoidDamageSource{})
+		return
+	}
+	m.effects.Tick(m.self.(Living))
+	m.tickFire(w)
+
+	m.targets.tick()
+	m.goals.tick()
+	m.tickNavigation(w)
+
+	move, fallen := m.tickMovement(w)
+	move.Send()
+	if fallen > 0 {
+		m.fall(w, fallen)
+	}
+	m.step(w, move)
+
+	m.checkEntityInsiders(w)
+	if m.conf.Tick != nil {
+		m.conf.Tick(m)
+	}
+
+	m.mu.Lock()
+	m.age += time.Second / 20
+	m.immunity = max(m.immunity-time.Second/20, 0)
+	m.mu.Unlock()
+}
+
+// tickFire ticks the fire of the mob, hurting it every second that it is on fire.
+func (m *Mob) tickFire(w *world.World) {
+	d := m.OnFireDuration()
+	if d <= 0 {
+		return
+	}
+	if m.InWater() || w.RainingAt(cube.PosFromVec3(m.Position())) {
+		m.Extinguish()
+		return
+	}
+	m.SetOnFire(d - time.Second/20)
+	if d%time.Second == 0 && !m.AttackImmune() {
+		m.self.(Living).Hurt(1, block.FireDamageSource{})
+	}
+}
+
+// tickNavigation advances the path that the mob is following and sets the position that the mob moves towards
+// during the tick. If one of the blocks along the path changed, the path is found again.
+func (m *Mob) tickNavigation(w *world.World) {
+	m.mu.Lock()
+	path, dest, speed, navigate := m.path, m.dest, m.navSpeed, m.navigate
+	m.navTicks++
+	ticks := m.navTicks
+	m.moving, m.steering = m.steering, false
+	m.mu.Unlock()
+	if !navigate {
+		return
+	}
+	if ticks%10 == 0 && !path.Valid(w) {
+		if !m.Navigate(dest, speed) {
+			return
+		}
+		path, _ = m.Path()
+	}
+	pos := m.Position()
+	node, ok := path.Current()
+	for ok {
+		diff := node.Vec3().Sub(pos)
+		if math.Hypot(diff[0], diff[2]) >= 0.5 || math.Abs(diff[1]) >= 1.5 {
+			break
+		}
+		path.Advance()
+		m.mu.Lock()
+		m.navTicks = 0
+		m.mu.Unlock()
+		node, ok = path.Current()
+	}
+	if !ok || ticks > 100 {
+		// Either the end of the path was reached, or the mob has been stuck on the same node for five seconds.
+		m.StopNavigating()
+		return
+	}
+	if node.Type == pathfind.NodeTypeDoor() && node.Vec3().Sub(pos).Len() < 2 {
+		for _, p := range []cube.Pos{node.Pos, node.Pos.Side(cube.FaceUp)} {
+			if door, ok := w.Block(p).(block.WoodDoor); ok && !door.Open {
+				door.Activate(p, cube.FaceUp, w, nil, nil)
+				break
+			}
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.move, m.moving = node.Vec3(), true
+	if node.Type == pathfind.NodeTypeJump() && node.Vec3().Sub(pos).Len() < 1.5 {
+		m.jumping = true
+	}
+}
+
+// tickMovement moves the mob towards the position it is moving to, turns it towards the position it looks at and
+// applies gravity, drag and collisions to it. If the mob landed on the ground, the distance it fell is returned, so
+// that fall may be called once the lock of the mob is released.
+func (m *Mob) tickMovement(w *world.World) (move *Movement, fallen float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	pos, vel, rot, onGround := m.pos, m.vel, m.rot, m.mc.OnGround()
+	if m.moving {
+		diff := m.move.Sub(pos)
+		horizontal := mgl64.Vec3{diff[0], 0, diff[2]}
+		if horizontal.Len() > mgl64.Epsilon {
+			horizontal = horizontal.Normalize()
+		}
+		acceleration := m.speed * m.navSpeed * 0.02
+		if onGround {
+			acceleration = m.speed * m.navSpeed * 0.28
+		} else if m.submerged {
+			acceleration = m.speed * m.navSpeed * 0.08
+		}
+		vel = vel.Add(horizontal.Mul(acceleration))
+		if m.collided && m.conf.Climbs {
+			// Climbing mobs move straight up walls that they walk into instead of jumping.
+			vel[1], m.fallDistance = 0.2, 0
+		} else if m.collided {
+			m.jumping = true
+		}
+		if m.submerged && diff[1] > 0 {
+			vel[1] += 0.04
+		}
+		if !m.looking {
+			rot = rotationTowards(pos, mgl64.Vec3{m.move[0], pos[1], m.move[2]})
+		}
+	}
+	if m.looking {
+		rot = rotationTowards(pos.Add(mgl64.Vec3{0, m.conf.EyeHeight}), m.look)
+		m.looking = false
+	}
+	if m.jumping && onGround {
+		vel[1] = 0.42
+		if boost, ok := m.effects.Effect(effect.JumpBoost{}); ok {
+			vel[1] += float64(boost.Level()) * 0.1
+		}
+	}
+	m.jumping = false
+
+	m.submerged = false
+	if l, ok := w.Liquid(cube.PosFromVec3(pos)); ok {
+		if _, water := l.(block.Water); water {
+			m.submerged = true
+		}
+	}
+	if m.submerged {
+		// Water slows down mobs and largely counteracts gravity.
+		vel = vel.Mul(0.8)
+		vel[1] += 0.06
+		m.fallDistance = 0
+	}
+
+	velBefore := vel
+	move = m.mc.TickMovement(m.self, pos, vel, rot)
+	m.collided = (velBefore[0] != 0 && move.vel[0] == 0) || (velBefore[2] != 0 && move.vel[2] == 0)
+	m.pos, m.vel, m.rot = move.pos, move.vel, move.rot
+
+	if move.dpos[1] < 0 {
+		m.fallDistance -= move.dpos[1]
+	} else if move.dpos[1] > 0 {
+		m.fallDistance = 0
+	}
+	if move.onGround && m.fallDistance > 0 {
+		fallen, m.fallDistance = m.fallDistance, 0
+	}
+	return move, fallen
+}
+
+// fall is called when the mob hits the ground after falling the distance passed.
+func (m *Mob) fall(w *world.World, distance float64) {
+	pos := cube.PosFromVec3(m.Position())
+	b := w.Block(pos)
+	if len(b.Model().BBox(pos, w)) == 0 {
+		pos = pos.Side(cube.FaceDown)
+		b = w.Block(pos)
+	}
+	if h, ok := b.(block.EntityLander); ok {
+		h.EntityLand(pos, w, m.self, &distance)
+	}
+	if distance >= 1 {
+		w.EmitGameEvent(m.Position(), world.GameEventHitGround(), m.self)
+	}
+	dmg := distance - 3
+	if boost, ok := m.Effect(effect.JumpBoost{}); ok {
+		dmg -= float64(boost.Level())
+	}
+	if dmg >= 0.5 && !m.conf.DisableFallDamage {
+		m.self.(Living).Hurt(math.Ceil(dmg), FallDamageSource{})
+	}
+}
+
+// step calls EntityStepOn on the block below the mob every time the mob has walked far enough over the ground.
+func (m *Mob) step(w *world.World, move *Movement) {
+	if !move.onGround {
+		return
+	}
+	m.mu.Lock()
+	m.stepDistance += math.Hypot(move.dpos[0], move.dpos[2])
+	stepped := m.stepDistance >= 1.6
+	if stepped {
+		m.stepDistance = 0
+	}
+	m.mu.Unlock()
+
+	if stepped {
+		pos := cube.PosFromVec3(m.Position()).Side(cube.FaceDown)
+		if s, ok := w.Block(pos).(block.EntityStepper); ok {
+			s.EntityStepOn(pos, w, m.self)
+		}
+	}
+}
+
+// checkEntityInsiders calls EntityInside on any block or liquid that the mob is inside.
+func (m *Mob) checkEntityInsiders(w *world.World) {
+	box := m.Type().BBox(m.self).Translate(m.Position()).Grow(-0.0001)
+	low, high := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+
+	for y := low[1]; y <= high[1]; y++ {
+		for x := low[0]; x <= high[0]; x++ {
+			for z := low[2]; z <= high[2]; z++ {
+				pos := cube.Pos{x, y, z}
+				b := w.Block(pos)
+				if insider, ok := b.(block.EntityInsider); ok {
+					insider.EntityInside(pos, w, m.self)
+					if _, liquid := b.(world.Liquid); liquid {
+						continue
+					}
+				}
+				if l, ok := w.Liquid(pos); ok {
+					if insider, ok := l.(block.EntityInsider); ok {
+						insider.EntityInside(pos, w, m.self)
+					}
+				}
+			}
+		}
+	}
+}
+
+// viewState updates the state of the mob for all viewers near the position passed.
+func (m *Mob) viewState(pos mgl64.Vec3) {
+	if w := m.World(); w != nil {
+		for _, v := range w.Viewers(pos) {
+			v.ViewEntityState(m.self)
+		}
+	}
+}
+
+// dismountPassengers dismounts all riders of the mob if it is a world.Vehicle.
+func (m *Mob) dismountPassengers() {
+	if v, ok := m.self.(world.Vehicle); ok {
+		for _, r := range v.Passengers() {
+			v.Dismount(r)
+		}
+	}
+}
+
+// rotationTowards returns the rotation that an entity at the position from must have to look at the position to.
+func rotationTowards(from, to mgl64.Vec3) cube.Rotation {
+	diff := to.Sub(from)
+	return cube.Rotation{
+		mgl64.RadToDeg(math.Atan2(-diff[0], diff[2])),
+		mgl64.RadToDeg(math.Atan2(-diff[1], math.Hypot(diff[0], diff[2]))),
+	}
+}
+
+// Close closes the mob and removes it from the world.
+func (m *Mob) Close() error {
+	m.goals.close()
+	m.targets.close()
+	m.dismountPassengers()
+	if w := m.World(); w != nil {
+		w.RemoveEntity(m.self)
+	}
+	return nil
+}
+
+// encodeMobNBT encodes the mob passed to a map that can be encoded to NBT.
+func encodeMobNBT(m *Mob) map[string]any {
+	yaw, pitch := m.Rotation().Elem()
+	mainHand, offHand := m.HeldItems()
+	armour := make([]any, 0, 4)
+	for _, it := range m.armour.Slots() {
+		armour = append(armour, nbtconv.WriteItem(it, true))
+	}
+	return map[string]any{
+		"Pos":        nbtconv.Vec3ToFloat32Slice(m.Position()),
+		"Motion":     nbtconv.Vec3ToFloat32Slice(m.Velocity()),
+		"Yaw":        float32(yaw),
+		"Pitch":      float32(pitch),
+		"Health":     float32(m.Health()),
+		"CustomName": m.NameTag(),
+		"Fire":       int16(m.OnFireDuration() / (time.Second / 20)),
+		"Mainhand":   nbtconv.WriteItem(mainHand, true),
+		"Offhand":    nbtconv.WriteItem(offHand, true),
+		"Armor":      armour,
+	}
+}
+
+// decodeMobNBT decodes the data passed into the mob passed.
+func decodeMobNBT(data map[string]any, m *Mob) {
+	m.vel, m.rot = nbtconv.Vec3(data, "Motion"), nbtconv.Rotation(data)
+	if health, ok := data["Health"].(float32); ok {
+		m.health.AddHealth(float64(health) - m.Health())
+	}
+	m.name, m.fireDuration = nbtconv.String(data, "CustomName"), nbtconv.TickDuration[int16](data, "Fire")
+	m.mainHand, m.offHand = nbtconv.MapItem(data, "Mainhand"), nbtconv.MapItem(data, "Offhand")
+
+	var armour [4]item.Stack
+	for i, v := range nbtconv.Slice(data, "Armor") {
+		if it, ok := v.(map[string]any); ok && i < len(armour) {
+			armour[i] = nbtconv.Item(it, nil)
+		}
+	}
+	m.armour.Set(armour[0], armour[1], armour[2], armour[3])
+}
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Monster is a Mob that is hostile towards players. Monsters are removed from worlds with a difficulty that
+// has no hostile mobs. Monsters such as a Zombie or a Creeper embed a Monster.
+type Monster struct {
+	*Mob
+
+	burns bool
+}
+
+// newMonster creates a Monster embedded in the entity self using conf. If burns is true, the monster is set on
+// fire when it is exposed to daylight.
+func newMonster(conf MobConfig, t world.EntityType, pos mgl64.Vec3, self world.Entity, burns bool) *Monster {
+	mo := &Monster{burns: burns}
+	tick := conf.Tick
+	conf.Tick = func(m *Mob) {
+		mo.tick()
+		if tick != nil {
+			tick(m)
+		}
+	}
+	if conf.EquipmentDropChance == 0 {
+		conf.EquipmentDropChance = 0.085
+	}
+	mo.Mob = conf.new(t, pos, self)
+	return mo
+}
+
+// Hostile always returns true. Players cannot sleep while a Monster is close to their bed.
+func (mo *Monster) Hostile() bool {
+	return true
+}
+
+// tick removes the monster if the difficulty of its world does not allow hostile mobs and sets it on fire if
+// it is exposed to daylight.
+func (mo *Monster) tick() {
+	w := mo.World()
+	if w == nil {
+		return
+	}
+	if !world.HostileMobs(w.Difficulty()) {
+		_ = mo.Close()
+		return
+	}
+	if mo.burns && mo.OnFireDuration() <= 0 && mo.inDaylight(w) {
+		if helmet := mo.armour.Helmet(); !helmet.Empty() {
+			// A helmet protects the monster from the sun, but it is slowly damaged in the process.
+			if rand.Intn(10) == 0 {
+				mo.armour.SetHelmet(mo.damageItem(helmet, 1))
+			}
+			return
+		}
+		mo.SetOnFire(time.Second * 8)
+	}
+}
+
+// inDaylight checks if the monster is currently exposed to daylight, meaning it is day, the monster is not in
+// water or rain and nothing blocks the sky above its head.
+func (mo *Monster) inDaylight(w *world.World) bool {
+	if !w.Dimension().TimeCycle() || mo.InWater() {
+		return false
+	}
+	if t := w.Time() % 24000; t >= 12542 && t < 23460 {
+		return false
+	}
+	pos := cube.PosFromVec3(EyePosition(mo.self))
+	return !w.RainingAt(pos) && w.SkyLight(pos) >= 15
+}
+
+// monsterExperience returns the experience dropped by the monster passed when it is killed by a player: 5
+// experience, plus 1-3 experience for every item of equipment that it holds or wears.
+func monsterExperience(m *Mob) int {
+	xp := 5
+	mainHand, offHand := m.HeldItems()
+	for _, it := range append([]item.Stack{mainHand, offHand}, m.armour.Slots()...) {
+		if !it.Empty() {
+			xp += rand.Intn(3) + 1
+		}
+	}
+	return xp
+}
@@ -0,0 +1,148 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/player/skin"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"sync"
+)
+
+// NPCConfig holds the parameters used to create an NPC.
+type NPCConfig struct {
+	// Name is the name of the NPC, which is displayed in its name tag.
+	Name string
+	// Skin is the skin that the NPC is displayed with.
+	Skin skin.Skin
+	// MainHand and OffHand are the items held by the NPC.
+	MainHand, OffHand item.Stack
+	// Helmet, Chestplate, Leggings and Boots are the items worn as armour by the NPC.
+	Helmet, Chestplate, Leggings, Boots item.Stack
+	// LookDistance is the distance in blocks within which the NPC looks at the nearest player. If 0, the NPC does
+	// not look at players and keeps the rotation it was given.
+	LookDistance float64
+	// Interact is called when a player clicks the NPC, either by interacting with it or by attacking it. It may
+	// be nil.
+	Interact func(n *NPC, user item.User)
+}
+
+// NPC is a non-player character: an entity that is displayed as a player with a skin, but that is not controlled
+// by a connected client. NPCs cannot be hurt or knocked back and are not listed as players of the server, making
+// them suitable for lobby characters and fake players. NPCs are not saved to disk.
+type NPC struct {
+	*Mob
+
+	conf NPCConfig
+	uuid uuid.UUID
+
+	smu  sync.Mutex
+	skin skin.Skin
+}
+
+// New creates a new NPC using conf at the position passed.
+func (conf NPCConfig) New(pos mgl64.Vec3) *NPC {
+	n := &NPC{conf: conf, uuid: uuid.New(), skin: conf.Skin}
+	n.Mob = MobConfig{
+		EyeHeight:         1.62,
+		DisableFallDamage: true,
+		Tick: func(m *Mob) {
+			if conf.LookDistance <= 0 {
+				return
+			}
+			if target := nearestEntity(m, conf.LookDistance, isPlayer); target != nil {
+				m.LookAt(EyePosition(target))
+			}
+		},
+	}.new(NPCType{}, pos, n)
+	n.name, n.mainHand, n.offHand = conf.Name, conf.MainHand, conf.OffHand
+	n.armour.Set(conf.Helmet, conf.Chestplate, conf.Leggings, conf.Boots)
+	return n
+}
+
+// UUID returns the UUID of the NPC. It is unique for every NPC and does not belong to any connected player.
+func (n *NPC) UUID() uuid.UUID {
+	return n.uuid
+}
+
+// Name returns the name of the NPC. It is the same as its name tag.
+func (n *NPC) Name() string {
+	return n.NameTag()
+}
+
+// Skin returns the skin that the NPC is displayed with.
+func (n *NPC) Skin() skin.Skin {
+	n.smu.Lock()
+	defer n.smu.Unlock()
+	return n.skin
+}
+
+// SetSkin changes the skin of the NPC and updates it for all viewers.
+func (n *NPC) SetSkin(s skin.Skin) {
+	n.smu.Lock()
+	n.skin = s
+	n.smu.Unlock()
+
+	if w := n.World(); w != nil {
+		for _, v := range w.Viewers(n.Position()) {
+			v.ViewSkin(n)
+		}
+	}
+}
+
+// Emote makes the NPC perform the emote with the UUID passed for all viewers.
+func (n *NPC) Emote(emote uuid.UUID) {
+	if w := n.World(); w != nil {
+		for _, v := range w.Viewers(n.Position()) {
+			v.ViewEmote(n, emote)
+		}
+	}
+}
+
+// PlayAnimation makes the NPC play the animation with the name passed for all viewers. The animation has to be
+// from a resource pack or from the vanilla player animations.
+func (n *NPC) PlayAnimation(name string) {
+	if w := n.World(); w != nil {
+		for _, v := range w.Viewers(n.Position()) {
+			v.ViewEntityAnimation(n, name)
+		}
+	}
+}
+
+// Interact calls the Interact function of the NPCConfig with the user that clicked the NPC.
+func (n *NPC) Interact(user item.User, _ *item.UseContext) bool {
+	if n.conf.Interact == nil {
+		return false
+	}
+	n.conf.Interact(n, user)
+	return true
+}
+
+// Hurt does not damage the NPC. If the damage was dealt by a user attacking the NPC, the Interact function of the
+// NPCConfig is called instead.
+func (n *NPC) Hurt(_ float64, src world.DamageSource) (float64, bool) {
+	if s, ok := src.(AttackDamageSource); ok && n.conf.Interact != nil {
+		if user, ok := s.Attacker.(item.User); ok {
+			n.conf.Interact(n, user)
+		}
+	}
+	return 0, false
+}
+
+// KnockBack does nothing: NPCs cannot be knocked back.
+func (n *NPC) KnockBack(mgl64.Vec3, float64, float64) {}
+
+// Explode does nothing: NPCs are not affected by explosions.
+func (n *NPC) Explode(mgl64.Vec3, float64, block.ExplosionConfig) {}
+
+// NPCType is a world.EntityType implementation for NPC.
+type NPCType struct{}
+
+func (NPCType) EncodeEntity() string        { return "dragonfly:npc" }
+func (NPCType) NetworkEncodeEntity() string { return "minecraft:player" }
+func (NPCType) NetworkOffset() float64      { return 1.62 }
+func (NPCType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.8, 0.3)
+}
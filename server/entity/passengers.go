@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"slices"
+	"sync"
+)
+
+// PassengerManager manages the passengers of a world.Vehicle. It keeps track of the seats taken, shows the
+// mounting and dismounting of riders to viewers and moves riders along with the vehicle.
+type PassengerManager struct {
+	mu         sync.Mutex
+	seats      []mgl64.Vec3
+	passengers []world.Rider
+}
+
+// NewPassengerManager returns a new PassengerManager for a vehicle with the seats passed. Each seat is an offset
+// from the position of the vehicle. The first seat is that of the driver of the vehicle.
+func NewPassengerManager(seats ...mgl64.Vec3) *PassengerManager {
+	return &PassengerManager{seats: seats}
+}
+
+// Passengers returns the riders currently riding the vehicle, ordered by the seats they occupy.
+func (m *PassengerManager) Passengers() []world.Rider {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.passengers)
+}
+
+// Driver returns the rider in the first seat of the vehicle. False is returned if the vehicle has no passengers.
+func (m *PassengerManager) Driver() (world.Rider, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.passengers) == 0 {
+		return nil, false
+	}
+	return m.passengers[0], true
+}
+
+// SeatOffset returns the offset of the seat occupied by the rider passed. False is returned if the rider is not a
+// passenger of the vehicle.
+func (m *PassengerManager) SeatOffset(r world.Rider) (mgl64.Vec3, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if i := slices.Index(m.passengers, r); i != -1 {
+		return m.seats[i], true
+	}
+	return mgl64.Vec3{}, false
+}
+
+// Mount makes the rider passed ride the vehicle in the first free seat. If the rider is already riding another
+// vehicle, it is dismounted from it first. False is returned if all seats are taken or if the rider is already
+// a passenger of the vehicle.
+func (m *PassengerManager) Mount(vehicle world.Vehicle, r world.Rider) bool {
+	if r == world.Entity(vehicle) || r.World() != vehicle.World() {
+		return false
+	}
+	m.mu.Lock()
+	if len(m.passengers) >= len(m.seats) || slices.Contains(m.passengers, r) {
+		m.mu.Unlock()
+		return false
+	}
+	m.passengers = append(m.passengers, r)
+	driver, seat := len(m.passengers) == 1, m.seats[len(m.passengers)-1]
+	m.mu.Unlock()
+
+	if current, ok := r.Vehicle(); ok {
+		current.Dismount(r)
+	}
+	r.SetVehicle(vehicle)
+	r.MoveWithVehicle(seatPosition(vehicle, seat))
+	for _, v := range vehicle.World().Viewers(vehicle.Position()) {
+		v.ViewEntityMount(r, vehicle, driver)
+		v.ViewEntityState(r)
+	}
+	return true
+}
+
+// Dismount makes the rider passed stop riding the vehicle. The riders in the seats after that of the rider move
+// up a seat, so that the vehicle gets a new driver if the driver dismounts.
+func (m *PassengerManager) Dismount(vehicle world.Vehicle, r world.Rider) {
+	m.mu.Lock()
+	i := slices.Index(m.passengers, r)
+	if i == -1 {
+		m.mu.Unlock()
+		return
+	}
+	m.passengers = slices.Delete(m.passengers, i, i+1)
+	moved := slices.Clone(m.passengers[i:])
+	m.mu.Unlock()
+
+	r.SetVehicle(nil)
+	w := vehicle.World()
+	if w == nil {
+		return
+	}
+	r.MoveWithVehicle(vehicle.Position().Add(mgl64.Vec3{0, vehicle.Type().BBox(vehicle).Height()}))
+	for _, v := range w.Viewers(vehicle.Position()) {
+		v.ViewEntityDismount(r, vehicle)
+		v.ViewEntityState(r)
+		for j, other := range moved {
+			v.ViewEntityMount(other, vehicle, i+j == 0)
+			v.ViewEntityState(other)
+		}
+	}
+}
+
+// DismountAll makes all riders of the vehicle stop riding it, for example because the vehicle was destroyed.
+func (m *PassengerManager) DismountAll(vehicle world.Vehicle) {
+	for _, r := range m.Passengers() {
+		m.Dismount(vehicle, r)
+	}
+}
+
+// Tick moves all riders of the vehicle to their seats. Riders that died or left the world of the vehicle are
+// dismounted. If the vehicle itself died, all riders are dismounted.
+func (m *PassengerManager) Tick(vehicle world.Vehicle) {
+	if l, ok := vehicle.(Living); ok && l.Dead() {
+		m.DismountAll(vehicle)
+		return
+	}
+	w := vehicle.World()
+	for _, r := range m.Passengers() {
+		if l, ok := r.(Living); (ok && l.Dead()) || r.World() != w {
+			m.Dismount(vehicle, r)
+			continue
+		}
+		if seat, ok := m.SeatOffset(r); ok {
+			r.MoveWithVehicle(seatPosition(vehicle, seat))
+		}
+	}
+}
+
+// seatPosition returns the position of the seat with the offset passed on the vehicle passed. The offset is rotated
+// along with the yaw of the vehicle, so that a seat in front of the vehicle stays in front of it.
+func seatPosition(vehicle world.Vehicle, seat mgl64.Vec3) mgl64.Vec3 {
+	yaw := mgl64.DegToRad(vehicle.Rotation().Yaw())
+	sin, cos := math.Sin(yaw), math.Cos(yaw)
+	return vehicle.Position().Add(mgl64.Vec3{seat[0]*cos - seat[2]*sin, seat[1], seat[0]*sin + seat[2]*cos})
+}
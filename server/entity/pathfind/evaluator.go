@@ -0,0 +1,284 @@
+package pathfind
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+// evaluator evaluates the blocks of a world during a single search of a Pathfinder. It caches the result of
+// every block position evaluated and keeps track of the chunks that were read.
+type evaluator struct {
+	conf   Config
+	w      *world.World
+	cells  map[cube.Pos]cell
+	chunks map[world.ChunkPos]struct{}
+}
+
+// cell holds the result of evaluating a single block position.
+type cell struct {
+	kind cellKind
+	// feet is the height at which the feet of an entity standing in the cell are found.
+	feet float64
+}
+
+// cellKind describes whether an entity is able to stand in or move through a cell.
+type cellKind uint8
+
+const (
+	// cellBlocked is a cell that an entity cannot be in, either because it does not fit or because it is
+	// dangerous.
+	cellBlocked cellKind = iota
+	// cellOpen is a cell that an entity fits in, but that it cannot stand in, as there is no floor.
+	cellOpen
+	// cellStand is a cell that an entity is able to stand in.
+	cellStand
+	// cellDoor is a cell that an entity is able to stand in after opening a door.
+	cellDoor
+	// cellWater is a cell filled with water that an entity is able to swim through.
+	cellWater
+)
+
+// settle returns the node that an entity at the position passed ends up at, which is the first position at or
+// below it that the entity can stand or swim at. False is returned if no such position exists.
+func (e *evaluator) settle(pos cube.Pos) (Node, bool) {
+	for i := 0; i <= e.conf.MaxFallDistance+1; i++ {
+		c := e.cell(pos)
+		switch c.kind {
+		case cellStand, cellDoor:
+			return Node{Pos: pos, Y: c.feet, Type: NodeTypeWalk()}, true
+		case cellWater:
+			return Node{Pos: pos, Y: c.feet, Type: NodeTypeSwim()}, true
+		case cellBlocked:
+			return Node{}, false
+		}
+		pos = pos.Side(cube.FaceDown)
+	}
+	return Node{}, false
+}
+
+// neighbours returns all nodes that an entity at the node passed is able to move to directly.
+func (e *evaluator) neighbours(n Node) []Node {
+	nodes := make([]Node, 0, 10)
+	var (
+		straight [4]Node
+		found    [4]bool
+	)
+	for i, face := range cube.HorizontalFaces() {
+		offset := cube.Pos{}.Side(face)
+		if next, ok := e.step(n, offset[0], offset[2]); ok {
+			nodes, straight[i], found[i] = append(nodes, next), next, true
+		}
+	}
+	// Diagonal movement is only allowed over flat ground, and only if both straight neighbours along the way
+	// can be walked to as well, so that entities don't cut corners. Every face is paired with the next face
+	// in clockwise order.
+	for i := range straight {
+		j := (i + 1) % len(straight)
+		a, b := straight[i], straight[j]
+		if !found[i] || !found[j] || a.Type != NodeTypeWalk() || b.Type != NodeTypeWalk() || a.Pos[1] != n.Pos[1] || b.Pos[1] != n.Pos[1] {
+			continue
+		}
+		dx, dz := a.Pos[0]+b.Pos[0]-2*n.Pos[0], a.Pos[2]+b.Pos[2]-2*n.Pos[2]
+		if next, ok := e.step(n, dx, dz); ok && next.Type == NodeTypeWalk() && next.Pos[1] == n.Pos[1] {
+			nodes = append(nodes, next)
+		}
+	}
+	if n.Type == NodeTypeSwim() {
+		// Entities in water may also swim straight up or down.
+		for _, face := range []cube.Face{cube.FaceUp, cube.FaceDown} {
+			pos := n.Pos.Side(face)
+			if c := e.cell(pos); c.kind == cellWater {
+				nodes = append(nodes, Node{Pos: pos, Y: c.feet, Type: NodeTypeSwim()})
+			}
+		}
+	}
+	return nodes
+}
+
+// step returns the node that an entity at the node passed reaches when moving dx and dz blocks horizontally.
+// The entity may step up, jump up or fall down to reach the node. False is returned if the entity cannot move
+// in that direction.
+func (e *evaluator) step(from Node, dx, dz int) (Node, bool) {
+	pos := from.Pos.Add(cube.Pos{dx, 0, dz})
+	c := e.cell(pos)
+	switch c.kind {
+	case cellStand, cellDoor:
+		if c.feet-from.Y <= e.conf.StepHeight {
+			return e.node(pos, c, NodeTypeWalk()), true
+		}
+		return e.jump(from, pos, c)
+	case cellWater:
+		return Node{Pos: pos, Y: c.feet, Type: NodeTypeSwim()}, true
+	case cellOpen:
+		for i := 1; i <= e.conf.MaxFallDistance; i++ {
+			below := pos.Sub(cube.Pos{0, i})
+			switch c := e.cell(below); c.kind {
+			case cellStand, cellDoor:
+				return e.node(below, c, NodeTypeFall()), true
+			case cellWater:
+				return Node{Pos: below, Y: c.feet, Type: NodeTypeSwim()}, true
+			case cellBlocked:
+				return Node{}, false
+			}
+		}
+		return Node{}, false
+	}
+	// The position is blocked, so the only way to get past is to jump onto the block.
+	above := pos.Side(cube.FaceUp)
+	if c := e.cell(above); c.kind == cellStand || c.kind == cellDoor {
+		return e.jump(from, above, c)
+	}
+	return Node{}, false
+}
+
+// jump returns a node at the position passed that is reached by jumping from the node passed. False is returned
+// if the position is too high up or if there is not enough room above the entity to jump.
+func (e *evaluator) jump(from Node, pos cube.Pos, c cell) (Node, bool) {
+	if c.feet-from.Y > e.conf.JumpHeight || e.collides(from.Pos, c.feet, false) {
+		return Node{}, false
+	}
+	return e.node(pos, c, NodeTypeJump()), true
+}
+
+// node creates a node of the type passed at the position and cell passed. If the cell holds a door that has to
+// be opened, the node is turned into a door node.
+func (e *evaluator) node(pos cube.Pos, c cell, t NodeType) Node {
+	if c.kind == cellDoor {
+		t = NodeTypeDoor()
+	}
+	return Node{Pos: pos, Y: c.feet, Type: t}
+}
+
+// cell evaluates the block position passed, returning a cached result if the position was evaluated before.
+func (e *evaluator) cell(pos cube.Pos) cell {
+	if c, ok := e.cells[pos]; ok {
+		return c
+	}
+	c := e.evaluate(pos)
+	e.cells[pos] = c
+	return c
+}
+
+// evaluate evaluates whether an entity is able to stand in or move through the block position passed.
+func (e *evaluator) evaluate(pos cube.Pos) cell {
+	if pos.OutOfBounds(e.w.Range()) {
+		return cell{kind: cellBlocked}
+	}
+	e.track(pos)
+	if e.dangerous(pos) || e.dangerous(pos.Side(cube.FaceUp)) {
+		return cell{kind: cellBlocked}
+	}
+
+	if l, ok := e.w.Liquid(pos); ok {
+		if _, water := l.(block.Water); water {
+			if !e.conf.CanSwim || e.collides(pos, float64(pos[1]), false) {
+				return cell{kind: cellBlocked}
+			}
+			return cell{kind: cellWater, feet: float64(pos[1])}
+		}
+	}
+	feet, floor := e.floor(pos)
+	if e.collides(pos, feet, false) {
+		if e.conf.CanOpenDoors && floor && !e.collides(pos, feet, true) {
+			return cell{kind: cellDoor, feet: feet}
+		}
+		return cell{kind: cellBlocked}
+	}
+	if !floor {
+		return cell{kind: cellOpen, feet: feet}
+	}
+	return cell{kind: cellStand, feet: feet}
+}
+
+// floor returns the height that an entity standing in the block position passed would stand at. False is
+// returned if there is no floor for the entity to stand on in the position.
+func (e *evaluator) floor(pos cube.Pos) (float64, bool) {
+	feet, floor := float64(pos[1]), false
+	below := pos.Side(cube.FaceDown)
+	for _, box := range e.w.Block(below).Model().BBox(below, e.w) {
+		if top := box.Max()[1]; top >= 1-mgl64.Epsilon {
+			// Blocks taller than a full block, such as fences, raise the floor into the position itself.
+			feet, floor = math.Max(feet, float64(pos[1])+top-1), true
+		}
+	}
+	for _, box := range e.w.Block(pos).Model().BBox(pos, e.w) {
+		if top := box.Max()[1]; top <= 0.5 {
+			// Low blocks, such as slabs and carpets, may be stood on within the position itself.
+			feet, floor = math.Max(feet, float64(pos[1])+top), true
+		}
+	}
+	return feet, floor
+}
+
+// collides checks if the bounding box of an entity standing at the height passed in the column of the block
+// position passed collides with any blocks. If ignoreDoors is true, wooden doors are not considered.
+func (e *evaluator) collides(pos cube.Pos, feet float64, ignoreDoors bool) bool {
+	centre := mgl64.Vec3{float64(pos[0]) + 0.5, feet, float64(pos[2]) + 0.5}
+	halfWidth := e.conf.Width / 2
+	box := cube.Box(-halfWidth, 0, -halfWidth, halfWidth, e.conf.Height, halfWidth).Translate(centre)
+
+	low, high := cube.PosFromVec3(box.Min()), cube.PosFromVec3(box.Max())
+	for y := low[1] - 1; y <= high[1]; y++ {
+		for x := low[0]; x <= high[0]; x++ {
+			for z := low[2]; z <= high[2]; z++ {
+				p := cube.Pos{x, y, z}
+				b := e.w.Block(p)
+				if _, door := b.(block.WoodDoor); door && ignoreDoors {
+					continue
+				}
+				for _, bb := range b.Model().BBox(p, e.w) {
+					if bb.Translate(p.Vec3()).IntersectsWith(box) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// dangerous checks if the block position passed holds a block that hurts entities inside it, such as fire or
+// lava.
+func (e *evaluator) dangerous(pos cube.Pos) bool {
+	if pos.OutOfBounds(e.w.Range()) {
+		return false
+	}
+	switch b := e.w.Block(pos).(type) {
+	case block.Fire, block.Lava, block.Cactus:
+		return true
+	case block.Campfire:
+		return !b.Extinguished
+	}
+	if l, ok := e.w.Liquid(pos); ok {
+		if _, lava := l.(block.Lava); lava {
+			return true
+		}
+	}
+	return false
+}
+
+// track marks the chunk of the block position passed as read during the search.
+func (e *evaluator) track(pos cube.Pos) {
+	e.chunks[world.ChunkPos{int32(pos[0] >> 4), int32(pos[2] >> 4)}] = struct{}{}
+}
+
+// path builds a Path ending at the search node passed. If the node is nil, an empty path is returned. The
+// revisions of all chunks read during the search are stored in the path, so that it is invalidated once one of
+// them changes.
+func (e *evaluator) path(end *searchNode, partial bool) *Path {
+	var nodes []Node
+	for n := end; n != nil; n = n.parent {
+		nodes = append(nodes, n.Node)
+	}
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+	revisions := make(map[world.ChunkPos]uint64, len(e.chunks))
+	for pos := range e.chunks {
+		revisions[pos] = e.w.BlockRevision(pos)
+	}
+	return &Path{nodes: nodes, partial: partial, w: e.w, revisions: revisions}
+}
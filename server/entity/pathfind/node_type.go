@@ -0,0 +1,60 @@
+package pathfind
+
+// NodeType is the type of a Node, which specifies how an entity reaches the node.
+type NodeType struct {
+	nodeType
+}
+
+// NodeTypeWalk is the type of nodes that are reached by walking.
+func NodeTypeWalk() NodeType {
+	return NodeType{0}
+}
+
+// NodeTypeJump is the type of nodes that are reached by jumping up a block.
+func NodeTypeJump() NodeType {
+	return NodeType{1}
+}
+
+// NodeTypeFall is the type of nodes that are reached by falling down one or more blocks.
+func NodeTypeFall() NodeType {
+	return NodeType{2}
+}
+
+// NodeTypeSwim is the type of nodes that are reached by swimming through water.
+func NodeTypeSwim() NodeType {
+	return NodeType{3}
+}
+
+// NodeTypeDoor is the type of nodes that hold a closed door which must be opened to pass through.
+func NodeTypeDoor() NodeType {
+	return NodeType{4}
+}
+
+// NodeTypes returns all node types.
+func NodeTypes() []NodeType {
+	return []NodeType{NodeTypeWalk(), NodeTypeJump(), NodeTypeFall(), NodeTypeSwim(), NodeTypeDoor()}
+}
+
+type nodeType uint8
+
+// Uint8 returns the node type as a uint8.
+func (n nodeType) Uint8() uint8 {
+	return uint8(n)
+}
+
+// String ...
+func (n nodeType) String() string {
+	switch n {
+	case 0:
+		return "walk"
+	case 1:
+		return "jump"
+	case 2:
+		return "fall"
+	case 3:
+		return "swim"
+	case 4:
+		return "door"
+	}
+	panic("unknown node type")
+}
@@ -0,0 +1,94 @@
+package pathfind
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Node is a single position on a Path.
+type Node struct {
+	// Pos is the block position of the node. An entity following the path stands in this block.
+	Pos cube.Pos
+	// Y is the exact height at which the feet of an entity standing at the node are found. It may differ from
+	// Pos[1] if the entity stands on a block such as a slab.
+	Y float64
+	// Type is the type of the node.
+	Type NodeType
+}
+
+// Vec3 returns the position that an entity following the path moves to when reaching the node.
+func (n Node) Vec3() mgl64.Vec3 {
+	return mgl64.Vec3{float64(n.Pos[0]) + 0.5, n.Y, float64(n.Pos[2]) + 0.5}
+}
+
+// Path is a path through a world found by a Pathfinder. An entity follows a path by moving towards the
+// current node and advancing to the next node once it reaches it.
+type Path struct {
+	nodes     []Node
+	index     int
+	partial   bool
+	w         *world.World
+	revisions map[world.ChunkPos]uint64
+}
+
+// Nodes returns all nodes of the path, including the ones that were already passed.
+func (p *Path) Nodes() []Node {
+	return p.nodes
+}
+
+// Partial checks if the path does not end at the target passed to Pathfinder.Find, but at the position closest
+// to it that could be reached.
+func (p *Path) Partial() bool {
+	return p.partial
+}
+
+// Current returns the node that an entity following the path is currently moving towards. False is returned if
+// the end of the path was reached.
+func (p *Path) Current() (Node, bool) {
+	if p.Finished() {
+		return Node{}, false
+	}
+	return p.nodes[p.index], true
+}
+
+// Advance advances the path to the next node.
+func (p *Path) Advance() {
+	if !p.Finished() {
+		p.index++
+	}
+}
+
+// Finished checks if the end of the path was reached.
+func (p *Path) Finished() bool {
+	return p.index >= len(p.nodes)
+}
+
+// End returns the last node of the path. False is returned if the path has no nodes.
+func (p *Path) End() (Node, bool) {
+	if len(p.nodes) == 0 {
+		return Node{}, false
+	}
+	return p.nodes[len(p.nodes)-1], true
+}
+
+// Valid checks if none of the blocks that were evaluated to find the path have changed since, meaning the path
+// may still be followed. Valid must only be called while the world of the path is being ticked, such as from
+// the Tick method of an entity.
+func (p *Path) Valid(w *world.World) bool {
+	if w != p.w {
+		return false
+	}
+	for pos, rev := range p.revisions {
+		if w.BlockRevision(pos) != rev {
+			return false
+		}
+	}
+	return true
+}
+
+// clone returns a copy of the path that starts at its first node. The nodes and revisions are shared with the
+// original path, as they are never modified after the path is found.
+func (p *Path) clone() *Path {
+	return &Path{nodes: p.nodes, partial: p.partial, w: p.w, revisions: p.revisions}
+}
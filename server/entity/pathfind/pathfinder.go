@@ -0,0 +1,230 @@
+package pathfind
+
+import (
+	"container/heap"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"sync"
+)
+
+// Config holds the parameters of a Pathfinder, which describe the size and abilities of the entities that follow
+// the paths it finds.
+type Config struct {
+	// Width and Height are the width and height of the bounding box of the entity following the paths found. If
+	// 0, a width of 0.6 and a height of 1.8 are used.
+	Width, Height float64
+	// StepHeight is the maximum height that the entity is able to step up without jumping. If 0, a step height
+	// of 0.6 is used.
+	StepHeight float64
+	// JumpHeight is the maximum height that the entity is able to jump up. If 0, a jump height of 1.25 is used.
+	JumpHeight float64
+	// MaxFallDistance is the maximum amount of blocks that the entity is willing to fall down. If 0, a maximum
+	// fall distance of 3 is used.
+	MaxFallDistance int
+	// CanSwim specifies if the entity is able to swim through water. Entities that cannot swim avoid water
+	// entirely.
+	CanSwim bool
+	// CanOpenDoors specifies if the entity is able to open wooden doors. Entities that cannot open doors treat
+	// closed doors as walls.
+	CanOpenDoors bool
+	// WaterCost is the cost of moving through a block of water, relative to a cost of 1 for walking a block. If
+	// 0, a cost of 4 is used.
+	WaterCost float64
+	// Range is the maximum distance from the start of a path that nodes may be found at. If 0, a range of 32 is
+	// used.
+	Range float64
+	// MaxNodes is the maximum number of nodes evaluated when searching a path. If the target cannot be reached
+	// within this number of nodes, a partial path to the position closest to the target is returned. If 0, a
+	// maximum of 400 nodes is used.
+	MaxNodes int
+}
+
+// New creates a Pathfinder using the parameters in conf.
+func (conf Config) New() *Pathfinder {
+	if conf.Width == 0 {
+		conf.Width = 0.6
+	}
+	if conf.Height == 0 {
+		conf.Height = 1.8
+	}
+	if conf.StepHeight == 0 {
+		conf.StepHeight = 0.6
+	}
+	if conf.JumpHeight == 0 {
+		conf.JumpHeight = 1.25
+	}
+	if conf.MaxFallDistance == 0 {
+		conf.MaxFallDistance = 3
+	}
+	if conf.WaterCost == 0 {
+		conf.WaterCost = 4
+	}
+	if conf.Range == 0 {
+		conf.Range = 32
+	}
+	if conf.MaxNodes == 0 {
+		conf.MaxNodes = 400
+	}
+	return &Pathfinder{conf: conf, cache: map[cacheKey]*Path{}}
+}
+
+// Pathfinder finds paths through a world using the A* search algorithm. Whether an entity is able to move
+// through a block is decided using the models of the blocks around it. Paths found are cached until one of the
+// blocks evaluated to find them changes. A Pathfinder may be shared between multiple entities of the same
+// size and abilities, but it keeps the worlds that its cached paths were found in referenced.
+type Pathfinder struct {
+	conf Config
+
+	mu    sync.Mutex
+	cache map[cacheKey]*Path
+}
+
+// cacheKey is the key of a path in the cache of a Pathfinder.
+type cacheKey struct {
+	w          *world.World
+	start, end cube.Pos
+}
+
+// maxCachedPaths is the maximum amount of paths that a Pathfinder keeps cached.
+const maxCachedPaths = 64
+
+// Find finds a path from the start position to the end position passed. If the end position cannot be reached,
+// a partial path to the position closest to it is returned. False is returned if no path could be found at all.
+// Find must only be called while the world passed is being ticked, such as from the Tick method of an entity,
+// as it reads the blocks of the world directly.
+func (p *Pathfinder) Find(w *world.World, start, end mgl64.Vec3) (*Path, bool) {
+	key := cacheKey{w: w, start: cube.PosFromVec3(start), end: cube.PosFromVec3(end)}
+
+	p.mu.Lock()
+	cached, ok := p.cache[key]
+	p.mu.Unlock()
+	if !ok || !cached.Valid(w) {
+		cached = p.search(w, key.start, key.end)
+
+		p.mu.Lock()
+		for k := range p.cache {
+			if len(p.cache) < maxCachedPaths {
+				break
+			}
+			delete(p.cache, k)
+		}
+		p.cache[key] = cached
+		p.mu.Unlock()
+	}
+	if len(cached.nodes) == 0 {
+		return nil, false
+	}
+	return cached.clone(), true
+}
+
+// search performs an A* search from the start position to the end position passed.
+func (p *Pathfinder) search(w *world.World, start, end cube.Pos) *Path {
+	e := &evaluator{conf: p.conf, w: w, cells: map[cube.Pos]cell{}, chunks: map[world.ChunkPos]struct{}{}}
+	// Make sure the chunks of the start and end position are tracked, even if they are never evaluated.
+	e.track(start)
+	e.track(end)
+
+	startNode, ok := e.settle(start)
+	if !ok {
+		return e.path(nil, false)
+	}
+	if goal, ok := e.settle(end); ok {
+		end = goal.Pos
+	}
+	origin := startNode.Vec3()
+
+	first := &searchNode{Node: startNode, h: heuristic(startNode.Pos, end)}
+	open := &nodeQueue{first}
+	visited := map[cube.Pos]*searchNode{startNode.Pos: first}
+	closest := first
+
+	for evaluated := 0; open.Len() > 0 && evaluated < p.conf.MaxNodes; evaluated++ {
+		n := heap.Pop(open).(*searchNode)
+		n.closed = true
+		if n.Pos == end {
+			return e.path(n, false)
+		}
+		if n.h < closest.h {
+			closest = n
+		}
+		for _, next := range e.neighbours(n.Node) {
+			if next.Vec3().Sub(origin).Len() > p.conf.Range {
+				continue
+			}
+			g := n.g + e.cost(n.Node, next)
+			if existing, ok := visited[next.Pos]; ok {
+				if existing.closed || g >= existing.g {
+					continue
+				}
+				existing.Node, existing.g, existing.parent = next, g, n
+				heap.Fix(open, existing.index)
+				continue
+			}
+			sn := &searchNode{Node: next, g: g, h: heuristic(next.Pos, end), parent: n}
+			visited[next.Pos] = sn
+			heap.Push(open, sn)
+		}
+	}
+	if closest == first {
+		return e.path(nil, false)
+	}
+	return e.path(closest, true)
+}
+
+// heuristic returns the estimated cost of moving from one position to another.
+func heuristic(from, to cube.Pos) float64 {
+	return from.Vec3().Sub(to.Vec3()).Len()
+}
+
+// searchNode is a Node that is evaluated during a search.
+type searchNode struct {
+	Node
+	g, h   float64
+	parent *searchNode
+	closed bool
+	index  int
+}
+
+// nodeQueue is a priority queue of search nodes, ordered by their estimated total cost.
+type nodeQueue []*searchNode
+
+func (q nodeQueue) Len() int { return len(q) }
+func (q nodeQueue) Less(i, j int) bool {
+	return q[i].g+q[i].h < q[j].g+q[j].h
+}
+func (q nodeQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index, q[j].index = i, j
+}
+func (q *nodeQueue) Push(x any) {
+	n := x.(*searchNode)
+	n.index = len(*q)
+	*q = append(*q, n)
+}
+func (q *nodeQueue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
+
+// cost returns the cost of moving from one node to another.
+func (e *evaluator) cost(from, to Node) float64 {
+	c := 1.0
+	if from.Pos[0] != to.Pos[0] && from.Pos[2] != to.Pos[2] {
+		c = math.Sqrt2
+	}
+	switch to.Type {
+	case NodeTypeJump():
+		c += 1
+	case NodeTypeFall():
+		c += math.Abs(from.Y-to.Y) / 2
+	case NodeTypeSwim():
+		c *= e.conf.WaterCost
+	case NodeTypeDoor():
+		c += 2
+	}
+	return c
+}
@@ -0,0 +1,244 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+// Pig is a passive animal that drops porkchops when killed. A saddle may be put on a pig, after which it can be
+// ridden and steered using a carrot on a stick.
+type Pig struct {
+	*Animal
+
+	passengers *PassengerManager
+
+	mu      sync.Mutex
+	saddled bool
+	// boost is the amount of ticks left that the pig is boosted for after its rider used a carrot on a stick,
+	// and boostTime is the total duration of that boost.
+	boost, boostTime int
+}
+
+// pigPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every pig.
+var pigPathfinder = pathfind.Config{Width: 0.9, Height: 0.9, CanSwim: true}
+
+// NewPig creates a new adult Pig at the position passed.
+func NewPig(pos mgl64.Vec3) *Pig {
+	p := &Pig{passengers: NewPassengerManager(mgl64.Vec3{0, 0.63, 0})}
+	p.Animal = newAnimal(MobConfig{
+		MaxHealth:  10,
+		EyeHeight:  0.8,
+		Pathfinder: pigPathfinder.New(),
+		Drops: func(*Mob, world.DamageSource) []item.Stack {
+			var saddle item.Stack
+			if p.Saddled() {
+				saddle = item.NewStack(item.Saddle{}, 1)
+			}
+			return p.drops(item.NewStack(item.Porkchop{Cooked: p.OnFireDuration() > 0}, rand.Intn(3)+1), saddle)
+		},
+		Experience: func(*Mob) int { return p.experience() },
+		Tick: func(*Mob) {
+			p.tickBoost()
+			p.passengers.Tick(p)
+		},
+	}, PigType{}, pos, p, pigFood, func(pos mgl64.Vec3, _ *Animal) breedable {
+		return NewPig(pos)
+	})
+	addAnimalGoals(p.Animal, 1.2)
+	p.Goals().Add(0, &pigSteerGoal{})
+	p.Goals().Add(3, NewTemptGoal(1.2, func(it world.Item) bool {
+		_, ok := it.(item.CarrotOnAStick)
+		return ok
+	}))
+	return p
+}
+
+// pigFood checks if the item passed may be fed to a pig.
+func pigFood(it world.Item) bool {
+	switch it.(type) {
+	case block.Carrot, block.Potato, item.Beetroot:
+		return true
+	}
+	return false
+}
+
+// Saddled checks if a saddle was put on the pig.
+func (p *Pig) Saddled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.saddled
+}
+
+// SetSaddled puts a saddle on the pig if true is passed, or removes it if false is passed.
+func (p *Pig) SetSaddled(saddled bool) {
+	p.mu.Lock()
+	p.saddled = saddled
+	p.mu.Unlock()
+	p.viewState(p.Position())
+}
+
+// Boost boosts the speed of the pig for a random duration, as happens when its rider uses a carrot on a stick.
+// False is returned if the pig is already boosted.
+func (p *Pig) Boost() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.boost > 0 {
+		return false
+	}
+	p.boostTime = rand.Intn(841) + 140
+	p.boost = p.boostTime
+	return true
+}
+
+// BoostMultiplier returns the multiplier of the speed of the pig that results from it being boosted. It
+// returns 1 if the pig is not boosted.
+func (p *Pig) BoostMultiplier() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.boost <= 0 {
+		return 1
+	}
+	progress := 1 - float64(p.boost)/float64(p.boostTime)
+	return 1 + 1.15*math.Sin(progress*math.Pi)
+}
+
+// tickBoost ticks the boost of the pig.
+func (p *Pig) tickBoost() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.boost > 0 {
+		p.boost--
+	}
+}
+
+// Passengers returns the riders of the pig. A pig has a single seat.
+func (p *Pig) Passengers() []world.Rider {
+	return p.passengers.Passengers()
+}
+
+// Mount makes the rider passed ride the pig. False is returned if the pig is already ridden or if it has no
+// saddle.
+func (p *Pig) Mount(r world.Rider) bool {
+	if !p.Saddled() || p.Dead() {
+		return false
+	}
+	return p.passengers.Mount(p, r)
+}
+
+// Dismount makes the rider passed stop riding the pig.
+func (p *Pig) Dismount(r world.Rider) {
+	p.passengers.Dismount(p, r)
+}
+
+// SeatOffset ...
+func (p *Pig) SeatOffset(r world.Rider) (mgl64.Vec3, bool) {
+	return p.passengers.SeatOffset(r)
+}
+
+// Interact puts a saddle on the pig if the user interacts with it using a saddle. If not, the item held is fed
+// to the pig if it is a carrot, potato or beetroot. If the pig is saddled and the item held cannot be fed to it,
+// the user mounts the pig.
+func (p *Pig) Interact(user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	if _, ok := held.Item().(item.Saddle); ok && !p.Saddled() && !p.Baby() && !p.Dead() {
+		p.SetSaddled(true)
+		ctx.SubtractFromCount(1)
+		p.World().PlaySound(p.Position(), sound.Saddle{})
+		return true
+	}
+	if r, ok := user.(world.Rider); ok && p.Saddled() && !pigFood(held.Item()) {
+		return p.Mount(r)
+	}
+	return p.Animal.Interact(user, ctx)
+}
+
+// pigSteerGoal is a Goal that makes a Pig walk in the direction that its driver looks in, as long as the driver
+// holds a carrot on a stick.
+type pigSteerGoal struct{}
+
+// Flags ...
+func (*pigSteerGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook
+}
+
+// CanStart ...
+func (*pigSteerGoal) CanStart(m *Mob) bool {
+	p, ok := m.Entity().(*Pig)
+	if !ok {
+		return false
+	}
+	driver, ok := p.passengers.Driver()
+	if !ok {
+		return false
+	}
+	c, ok := driver.(item.Carrier)
+	if !ok {
+		return false
+	}
+	held, _ := c.HeldItems()
+	_, ok = held.Item().(item.CarrotOnAStick)
+	return ok
+}
+
+// CanContinue ...
+func (g *pigSteerGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (*pigSteerGoal) Start(m *Mob) {
+	m.StopNavigating()
+}
+
+// Stop ...
+func (*pigSteerGoal) Stop(*Mob) {}
+
+// Tick ...
+func (*pigSteerGoal) Tick(m *Mob) {
+	p := m.Entity().(*Pig)
+	driver, ok := p.passengers.Driver()
+	if !ok {
+		return
+	}
+	dir := cube.Rotation{driver.Rotation().Yaw(), 0}.Vec3()
+	m.Steer(m.Position().Add(dir.Mul(2)), p.BoostMultiplier())
+}
+
+// PigType is a world.EntityType implementation for Pig.
+type PigType struct{}
+
+func (PigType) EncodeEntity() string           { return "minecraft:pig" }
+func (PigType) MobCategory() world.MobCategory { return world.CreatureCategory() }
+func (PigType) CanSpawn(pos cube.Pos, w *world.World) bool {
+	return animalSpawnable(pos, w)
+}
+func (PigType) Spawn(pos mgl64.Vec3) world.Entity { return NewPig(pos) }
+func (PigType) BBox(e world.Entity) cube.BBox {
+	if a, ok := e.(breedable); ok && a.animal().Baby() {
+		return cube.Box(-0.225, 0, -0.225, 0.225, 0.45, 0.225)
+	}
+	return cube.Box(-0.45, 0, -0.45, 0.45, 0.9, 0.45)
+}
+
+func (PigType) DecodeNBT(m map[string]any) world.Entity {
+	p := NewPig(nbtconv.Vec3(m, "Pos"))
+	decodeAnimalNBT(m, p.Animal)
+	p.saddled = nbtconv.Bool(m, "Saddled")
+	return p
+}
+
+func (PigType) EncodeNBT(e world.Entity) map[string]any {
+	p := e.(*Pig)
+	data := encodeAnimalNBT(p.Animal)
+	data["Saddled"] = boolByte(p.Saddled())
+	return data
+}
@@ -0,0 +1,176 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Profession is the profession of a Villager. A Villager obtains a profession by claiming the workstation block of
+// that profession, after which it offers trades specific to the profession.
+type Profession struct {
+	profession
+}
+
+// NoProfession returns the Profession of a Villager that has not yet claimed a workstation.
+func NoProfession() Profession {
+	return Profession{0}
+}
+
+// Farmer returns the farmer Profession, of which the workstation is a composter.
+func Farmer() Profession {
+	return Profession{1}
+}
+
+// Fisherman returns the fisherman Profession, of which the workstation is a barrel.
+func Fisherman() Profession {
+	return Profession{2}
+}
+
+// Shepherd returns the shepherd Profession, of which the workstation is a loom.
+func Shepherd() Profession {
+	return Profession{3}
+}
+
+// Fletcher returns the fletcher Profession, of which the workstation is a fletching table.
+func Fletcher() Profession {
+	return Profession{4}
+}
+
+// Librarian returns the librarian Profession, of which the workstation is a lectern.
+func Librarian() Profession {
+	return Profession{5}
+}
+
+// Armourer returns the armourer Profession, of which the workstation is a blast furnace.
+func Armourer() Profession {
+	return Profession{8}
+}
+
+// Weaponsmith returns the weaponsmith Profession, of which the workstation is a grindstone.
+func Weaponsmith() Profession {
+	return Profession{9}
+}
+
+// Toolsmith returns the toolsmith Profession, of which the workstation is a smithing table.
+func Toolsmith() Profession {
+	return Profession{10}
+}
+
+// Butcher returns the butcher Profession, of which the workstation is a smoker.
+func Butcher() Profession {
+	return Profession{11}
+}
+
+// Mason returns the mason Profession, of which the workstation is a stonecutter.
+func Mason() Profession {
+	return Profession{13}
+}
+
+// Professions returns all professions that a Villager may obtain by claiming a workstation.
+func Professions() []Profession {
+	return []Profession{Farmer(), Fisherman(), Shepherd(), Fletcher(), Librarian(), Armourer(), Weaponsmith(), Toolsmith(), Butcher(), Mason()}
+}
+
+// professionByWorkstation returns the Profession of which the block passed is the workstation. False is returned
+// if the block is not a workstation.
+func professionByWorkstation(b world.Block) (Profession, bool) {
+	for _, p := range Professions() {
+		if p.Workstation(b) {
+			return p, true
+		}
+	}
+	return NoProfession(), false
+}
+
+type profession uint8
+
+// Uint8 returns the profession as a uint8.
+func (p profession) Uint8() uint8 {
+	return uint8(p)
+}
+
+// Workstation checks if the block passed is the workstation of the profession.
+func (p profession) Workstation(b world.Block) bool {
+	var ok bool
+	switch p {
+	case 1:
+		_, ok = b.(block.Composter)
+	case 2:
+		_, ok = b.(block.Barrel)
+	case 3:
+		_, ok = b.(block.Loom)
+	case 4:
+		_, ok = b.(block.FletchingTable)
+	case 5:
+		_, ok = b.(block.Lectern)
+	case 8:
+		_, ok = b.(block.BlastFurnace)
+	case 9:
+		_, ok = b.(block.Grindstone)
+	case 10:
+		_, ok = b.(block.SmithingTable)
+	case 11:
+		_, ok = b.(block.Smoker)
+	case 13:
+		_, ok = b.(block.Stonecutter)
+	}
+	return ok
+}
+
+// Name returns the name of the profession as displayed in the trade window.
+func (p profession) Name() string {
+	switch p {
+	case 0:
+		return "Villager"
+	case 1:
+		return "Farmer"
+	case 2:
+		return "Fisherman"
+	case 3:
+		return "Shepherd"
+	case 4:
+		return "Fletcher"
+	case 5:
+		return "Librarian"
+	case 8:
+		return "Armourer"
+	case 9:
+		return "Weaponsmith"
+	case 10:
+		return "Toolsmith"
+	case 11:
+		return "Butcher"
+	case 13:
+		return "Mason"
+	}
+	panic("unknown profession")
+}
+
+// String returns the profession as a string.
+func (p profession) String() string {
+	switch p {
+	case 0:
+		return "none"
+	case 1:
+		return "farmer"
+	case 2:
+		return "fisherman"
+	case 3:
+		return "shepherd"
+	case 4:
+		return "fletcher"
+	case 5:
+		return "librarian"
+	case 8:
+		return "armorer"
+	case 9:
+		return "weaponsmith"
+	case 10:
+		return "toolsmith"
+	case 11:
+		return "butcher"
+	case 13:
+		return "mason"
+	}
+	panic("unknown profession")
+}
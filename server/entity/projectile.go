@@ -169,8 +169,14 @@ func (lt *ProjectileBehaviour) Tick(e *Ent) *Movement {
 		}
 	case trace.BlockResult:
 		bpos := r.BlockPosition()
+		w.EmitGameEvent(r.Position(), world.GameEventProjectileLand(), e)
 		if t, ok := w.Block(bpos).(block.TNT); ok && e.OnFireDuration() > 0 {
-			t.Ignite(bpos, w, e)
+			igniter := world.Entity(e)
+			if lt.owner != nil {
+				// Burning projectiles, such as arrows shot with a Flame bow, credit the entity that shot them.
+				igniter = lt.owner
+			}
+			t.Ignite(bpos, w, igniter)
 		}
 		if lt.conf.SurviveBlockCollision {
 			lt.hitBlockSurviving(e, r, m)
@@ -0,0 +1,70 @@
+package entity
+
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
+// RangedAttackGoal is a Goal that makes a Mob attack its target from a distance, such as a skeleton shooting
+// arrows at its target. The Mob walks towards its target until it is within range and can see it.
+type RangedAttackGoal struct {
+	speed    float64
+	interval int
+	radius   float64
+	attack   func(m *Mob, target world.Entity)
+
+	seen, cooldown, repath int
+}
+
+// NewRangedAttackGoal creates a RangedAttackGoal that makes a Mob walk towards its target at the multiple of its
+// speed passed until it is within radius blocks of it. The attack function is then called every interval ticks
+// while the Mob can see its target.
+func NewRangedAttackGoal(speed float64, interval int, radius float64, attack func(m *Mob, target world.Entity)) *RangedAttackGoal {
+	return &RangedAttackGoal{speed: speed, interval: interval, radius: radius, attack: attack}
+}
+
+// Flags ...
+func (*RangedAttackGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook
+}
+
+// CanStart ...
+func (*RangedAttackGoal) CanStart(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// CanContinue ...
+func (g *RangedAttackGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (g *RangedAttackGoal) Start(*Mob) {
+	g.seen, g.cooldown, g.repath = 0, g.interval/2, 0
+}
+
+// Stop ...
+func (*RangedAttackGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *RangedAttackGoal) Tick(m *Mob) {
+	target := m.Target()
+	m.LookAt(EyePosition(target))
+
+	visible := m.CanSee(target)
+	if visible {
+		g.seen++
+	} else {
+		g.seen = 0
+	}
+	inRange := target.Position().Sub(m.Position()).Len() <= g.radius
+	if inRange && g.seen >= 20 {
+		m.StopNavigating()
+	} else if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		m.Navigate(target.Position(), g.speed)
+	}
+	if g.cooldown--; g.cooldown <= 0 && visible && inRange {
+		g.cooldown = g.interval
+		g.attack(m, target)
+	}
+}
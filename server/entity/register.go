@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/enchantment"
@@ -15,19 +16,35 @@ import (
 var DefaultRegistry = conf.New([]world.EntityType{
 	AreaEffectCloudType{},
 	ArrowType{},
+	BoatType{},
 	BottleOfEnchantingType{},
+	ChestBoatType{},
+	ChestMinecartType{},
+	ChickenType{},
+	CowType{},
+	CreeperType{},
 	EggType{},
 	EnderPearlType{},
 	ExperienceOrbType{},
 	FallingBlockType{},
 	FireworkType{},
+	HopperMinecartType{},
 	ItemType{},
 	LightningType{},
 	LingeringPotionType{},
+	MinecartType{},
+	NPCType{},
+	PigType{},
+	SheepType{},
+	SkeletonType{},
 	SnowballType{},
+	SpiderType{},
 	SplashPotionType{},
+	TNTMinecartType{},
 	TNTType{},
 	TextType{},
+	VillagerType{},
+	ZombieType{},
 })
 
 var conf = world.EntityRegistryConfig{
@@ -42,6 +59,24 @@ var conf = world.EntityRegistryConfig{
 	TNT: func(pos mgl64.Vec3, fuse time.Duration, igniter world.Entity) world.Entity {
 		return NewTNT(pos, fuse, igniter)
 	},
+	Boat: func(pos mgl64.Vec3, yaw float64, wood any, chest bool) world.Entity {
+		if chest {
+			return NewChestBoat(pos, yaw, wood.(block.WoodType))
+		}
+		return NewBoat(pos, yaw, wood.(block.WoodType))
+	},
+	Minecart: func(pos mgl64.Vec3) world.Entity {
+		return NewMinecart(pos)
+	},
+	ChestMinecart: func(pos mgl64.Vec3) world.Entity {
+		return NewChestMinecart(pos)
+	},
+	HopperMinecart: func(pos mgl64.Vec3) world.Entity {
+		return NewHopperMinecart(pos)
+	},
+	TNTMinecart: func(pos mgl64.Vec3) world.Entity {
+		return NewTNTMinecart(pos)
+	},
 	BottleOfEnchanting: func(pos, vel mgl64.Vec3, owner world.Entity) world.Entity {
 		b := NewBottleOfEnchanting(pos, owner)
 		b.vel = vel
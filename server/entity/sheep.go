@@ -0,0 +1,261 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"sync"
+)
+
+// Sheep is a passive animal that may be sheared using shears to obtain wool. The wool of a sheep may be dyed
+// and regrows when the sheep eats grass.
+type Sheep struct {
+	*Animal
+
+	mu      sync.Mutex
+	colour  item.Colour
+	sheared bool
+}
+
+// sheepPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every sheep.
+var sheepPathfinder = pathfind.Config{Width: 0.9, Height: 1.3, CanSwim: true}
+
+// NewSheep creates a new adult Sheep at the position passed. The colour of its wool is picked randomly, with
+// white being the most common colour.
+func NewSheep(pos mgl64.Vec3) *Sheep {
+	return NewSheepWithColour(pos, randomSheepColour())
+}
+
+// NewSheepWithColour creates a new adult Sheep at the position passed with wool of the colour passed.
+func NewSheepWithColour(pos mgl64.Vec3, colour item.Colour) *Sheep {
+	s := &Sheep{colour: colour}
+	s.Animal = newAnimal(MobConfig{
+		MaxHealth:  8,
+		EyeHeight:  1.2,
+		Pathfinder: sheepPathfinder.New(),
+		Drops: func(*Mob, world.DamageSource) []item.Stack {
+			var wool item.Stack
+			if !s.Sheared() {
+				wool = item.NewStack(block.Wool{Colour: s.Colour()}, 1)
+			}
+			return s.drops(wool, item.NewStack(item.Mutton{Cooked: s.OnFireDuration() > 0}, rand.Intn(2)+1))
+		},
+		Experience: func(*Mob) int { return s.experience() },
+	}, SheepType{}, pos, s, func(it world.Item) bool {
+		_, ok := it.(item.Wheat)
+		return ok
+	}, func(pos mgl64.Vec3, partner *Animal) breedable {
+		colour := s.Colour()
+		if other, ok := partner.Entity().(*Sheep); ok && rand.Intn(2) == 0 {
+			colour = other.Colour()
+		}
+		return NewSheepWithColour(pos, colour)
+	})
+	addAnimalGoals(s.Animal, 1.1)
+	s.Goals().Add(5, &eatGrassGoal{})
+	return s
+}
+
+// randomSheepColour returns a random colour for the wool of a sheep, using the same distribution as sheep
+// spawning naturally.
+func randomSheepColour() item.Colour {
+	switch n := rand.Intn(1000); {
+	case n < 50:
+		return item.ColourBlack()
+	case n < 100:
+		return item.ColourGrey()
+	case n < 150:
+		return item.ColourLightGrey()
+	case n < 180:
+		return item.ColourBrown()
+	case n < 182:
+		return item.ColourPink()
+	}
+	return item.ColourWhite()
+}
+
+// Colour returns the colour of the wool of the sheep.
+func (s *Sheep) Colour() item.Colour {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.colour
+}
+
+// SetColour changes the colour of the wool of the sheep.
+func (s *Sheep) SetColour(colour item.Colour) {
+	s.mu.Lock()
+	s.colour = colour
+	s.mu.Unlock()
+	s.viewState(s.Position())
+}
+
+// Sheared checks if the sheep was sheared and has not regrown its wool yet.
+func (s *Sheep) Sheared() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sheared
+}
+
+// SetSheared changes whether the sheep is sheared. Sheared sheep drop no wool when killed.
+func (s *Sheep) SetSheared(sheared bool) {
+	s.mu.Lock()
+	s.sheared = sheared
+	s.mu.Unlock()
+	s.viewState(s.Position())
+}
+
+// Shear shears the sheep, dropping 1-3 wool of its colour. False is returned if the sheep could not be sheared
+// because it is a baby or was already sheared.
+func (s *Sheep) Shear() bool {
+	if s.Baby() || s.Dead() || s.Sheared() {
+		return false
+	}
+	s.SetSheared(true)
+
+	w, pos := s.World(), s.Position()
+	w.PlaySound(pos, sound.Shear{})
+	for i := rand.Intn(3) + 1; i > 0; i-- {
+		it := NewItem(item.NewStack(block.Wool{Colour: s.Colour()}, 1), pos.Add(mgl64.Vec3{0, 1}))
+		it.vel = mgl64.Vec3{(rand.Float64() - rand.Float64()) * 0.1, rand.Float64() * 0.05, (rand.Float64() - rand.Float64()) * 0.1}
+		w.AddEntity(it)
+	}
+	return true
+}
+
+// Interact shears the sheep if the user interacts with it using shears, or dyes its wool if the user interacts
+// with it using a dye. If not, the item held is fed to the sheep if it is wheat.
+func (s *Sheep) Interact(user item.User, ctx *item.UseContext) bool {
+	held, _ := user.HeldItems()
+	switch it := held.Item().(type) {
+	case item.Shears:
+		if s.Shear() {
+			ctx.DamageItem(1)
+			return true
+		}
+	case item.Dye:
+		if it.Colour != s.Colour() && !s.Dead() {
+			s.SetColour(it.Colour)
+			ctx.SubtractFromCount(1)
+			return true
+		}
+	}
+	return s.Animal.Interact(user, ctx)
+}
+
+// eatGrass makes the sheep eat the grass it is standing in or on, regrowing its wool. Baby sheep grow up faster
+// when eating grass.
+func (s *Sheep) eatGrass() {
+	w, pos := s.World(), cube.PosFromVec3(s.Position())
+	if g, ok := w.Block(pos).(block.TallGrass); ok && g.Type == block.NormalTallGrass() {
+		w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: g})
+		w.SetBlock(pos, nil, nil)
+	} else if g, ok := w.Block(pos.Side(cube.FaceDown)).(block.Grass); ok {
+		w.AddParticle(pos.Side(cube.FaceDown).Vec3Centre(), particle.BlockBreak{Block: g})
+		w.SetBlock(pos.Side(cube.FaceDown), block.Dirt{}, nil)
+	} else {
+		return
+	}
+	s.SetSheared(false)
+	s.Grow(1200)
+}
+
+// eatGrassGoal is a Goal that makes a Sheep stop to eat the grass it is standing on every now and then.
+type eatGrassGoal struct {
+	ticks int
+}
+
+// Flags ...
+func (*eatGrassGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook | GoalFlagJump
+}
+
+// CanStart ...
+func (*eatGrassGoal) CanStart(m *Mob) bool {
+	s, ok := m.Entity().(*Sheep)
+	if !ok || !m.OnGround() {
+		return false
+	}
+	chance := 1000
+	if s.Baby() {
+		chance = 50
+	}
+	if rand.Intn(chance) != 0 {
+		return false
+	}
+	w, pos := m.World(), cube.PosFromVec3(m.Position())
+	if g, ok := w.Block(pos).(block.TallGrass); ok && g.Type == block.NormalTallGrass() {
+		return true
+	}
+	_, ok = w.Block(pos.Side(cube.FaceDown)).(block.Grass)
+	return ok
+}
+
+// CanContinue ...
+func (g *eatGrassGoal) CanContinue(*Mob) bool {
+	return g.ticks > 0
+}
+
+// Start ...
+func (g *eatGrassGoal) Start(m *Mob) {
+	g.ticks = 40
+	m.StopNavigating()
+	for _, v := range m.World().Viewers(m.Position()) {
+		v.ViewEntityAction(m.Entity(), EatGrassAction{})
+	}
+}
+
+// Stop ...
+func (g *eatGrassGoal) Stop(*Mob) {
+	g.ticks = 0
+}
+
+// Tick ...
+func (g *eatGrassGoal) Tick(m *Mob) {
+	if g.ticks--; g.ticks == 4 {
+		if s, ok := m.Entity().(*Sheep); ok {
+			s.eatGrass()
+		}
+	}
+}
+
+// SheepType is a world.EntityType implementation for Sheep.
+type SheepType struct{}
+
+func (SheepType) EncodeEntity() string           { return "minecraft:sheep" }
+func (SheepType) MobCategory() world.MobCategory { return world.CreatureCategory() }
+func (SheepType) CanSpawn(pos cube.Pos, w *world.World) bool {
+	return animalSpawnable(pos, w)
+}
+func (SheepType) Spawn(pos mgl64.Vec3) world.Entity { return NewSheep(pos) }
+func (SheepType) BBox(e world.Entity) cube.BBox {
+	if a, ok := e.(breedable); ok && a.animal().Baby() {
+		return cube.Box(-0.225, 0, -0.225, 0.225, 0.65, 0.225)
+	}
+	return cube.Box(-0.45, 0, -0.45, 0.45, 1.3, 0.45)
+}
+
+func (SheepType) DecodeNBT(m map[string]any) world.Entity {
+	colour := item.ColourWhite()
+	if c := int(nbtconv.Uint8(m, "Color")); c < len(item.Colours()) {
+		colour = item.Colours()[c]
+	}
+	s := NewSheepWithColour(nbtconv.Vec3(m, "Pos"), colour)
+	decodeAnimalNBT(m, s.Animal)
+	s.sheared = nbtconv.Bool(m, "Sheared")
+	return s
+}
+
+func (SheepType) EncodeNBT(e world.Entity) map[string]any {
+	s := e.(*Sheep)
+	data := encodeAnimalNBT(s.Animal)
+	data["Color"] = s.Colour().Uint8()
+	data["Sheared"] = boolByte(s.Sheared())
+	return data
+}
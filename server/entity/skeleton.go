@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+)
+
+// Skeleton is an undead Monster that shoots arrows at players using a bow. Skeletons burn in daylight.
+type Skeleton struct {
+	*Monster
+}
+
+// skeletonPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every skeleton.
+var skeletonPathfinder = pathfind.Config{Width: 0.6, Height: 1.99, CanSwim: true}
+
+// NewSkeleton creates a new Skeleton holding a bow at the position passed.
+func NewSkeleton(pos mgl64.Vec3) *Skeleton {
+	s := &Skeleton{}
+	s.Monster = newMonster(MobConfig{
+		EyeHeight:  1.62,
+		Pathfinder: skeletonPathfinder.New(),
+		Drops: func(*Mob, world.DamageSource) []item.Stack {
+			return []item.Stack{item.NewStack(item.Bone{}, rand.Intn(3)), item.NewStack(item.Arrow{}, rand.Intn(3))}
+		},
+		Experience: monsterExperience,
+	}, SkeletonType{}, pos, s, true)
+	s.mainHand = item.NewStack(item.Bow{}, 1)
+
+	goals, targets := s.Goals(), s.Targets()
+	goals.Add(0, NewFloatGoal())
+	goals.Add(4, NewRangedAttackGoal(1, 40, 15, shootArrow))
+	goals.Add(5, NewWanderGoal(1))
+	goals.Add(6, NewLookAtPlayerGoal(8))
+	goals.Add(6, NewLookAroundGoal())
+	targets.Add(1, NewHurtByTargetGoal())
+	targets.Add(2, NewNearestPlayerTargetGoal(16))
+	return s
+}
+
+// shootArrow makes the Mob passed shoot an arrow at the target passed. The arrow is less accurate on lower
+// difficulties.
+func shootArrow(m *Mob, target world.Entity) {
+	w := m.World()
+	start := EyePosition(m.Entity()).Sub(mgl64.Vec3{0, 0.1})
+	aim := target.Position().Add(mgl64.Vec3{0, target.Type().BBox(target).Height() / 3})
+
+	diff := aim.Sub(start)
+	// Aim a little higher the further away the target is, so that the arrow drops onto the target.
+	diff[1] += math.Hypot(diff[0], diff[2]) * 0.2
+
+	difficulty, _ := world.DifficultyID(w.Difficulty())
+	inaccuracy := float64(14-difficulty*4) * 0.0075
+	vel := diff.Normalize()
+	for i := range vel {
+		vel[i] += rand.NormFloat64() * inaccuracy
+	}
+	vel = vel.Mul(1.6)
+
+	a := NewArrow(start, rotationTowards(mgl64.Vec3{}, vel), m.Entity())
+	a.vel = vel
+	a.Behaviour().(*ProjectileBehaviour).conf.DisablePickup = true
+
+	for _, v := range w.Viewers(m.Position()) {
+		v.ViewEntityAction(m.Entity(), SwingArmAction{})
+	}
+	w.PlaySound(start, sound.BowShoot{})
+	w.AddEntity(a)
+}
+
+// SkeletonType is a world.EntityType implementation for Skeleton.
+type SkeletonType struct{}
+
+func (SkeletonType) EncodeEntity() string                 { return "minecraft:skeleton" }
+func (SkeletonType) MobCategory() world.MobCategory       { return world.MonsterCategory() }
+func (SkeletonType) CanSpawn(cube.Pos, *world.World) bool { return true }
+func (SkeletonType) Spawn(pos mgl64.Vec3) world.Entity    { return NewSkeleton(pos) }
+func (SkeletonType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.99, 0.3)
+}
+
+func (SkeletonType) DecodeNBT(m map[string]any) world.Entity {
+	s := NewSkeleton(nbtconv.Vec3(m, "Pos"))
+	decodeMobNBT(m, s.Mob)
+	return s
+}
+
+func (SkeletonType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Skeleton).Mob)
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Spider is a Monster that climbs up walls and leaps at its target. Spiders are only hostile in the dark, unless
+// they are attacked.
+type Spider struct {
+	*Monster
+}
+
+// spiderPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every spider.
+var spiderPathfinder = pathfind.Config{Width: 1.4, Height: 0.9, CanSwim: true}
+
+// NewSpider creates a new Spider at the position passed.
+func NewSpider(pos mgl64.Vec3) *Spider {
+	s := &Spider{}
+	s.Monster = newMonster(MobConfig{
+		MaxHealth:  16,
+		Speed:      0.3,
+		EyeHeight:  0.65,
+		Climbs:     true,
+		Pathfinder: spiderPathfinder.New(),
+		Drops: func(m *Mob, _ world.DamageSource) []item.Stack {
+			drops := []item.Stack{item.NewStack(item.String{}, rand.Intn(3))}
+			if m.killedByPlayer() && rand.Intn(3) == 0 {
+				drops = append(drops, item.NewStack(item.SpiderEye{}, 1))
+			}
+			return drops
+		},
+		Experience: monsterExperience,
+		Tick:       func(*Mob) { s.calmDown() },
+	}, SpiderType{}, pos, s, false)
+
+	goals, targets := s.Goals(), s.Targets()
+	goals.Add(1, NewFloatGoal())
+	goals.Add(3, NewLeapAtTargetGoal(0.4))
+	goals.Add(4, NewMeleeAttackGoal(1))
+	goals.Add(5, NewWanderGoal(0.8))
+	goals.Add(6, NewLookAtPlayerGoal(8))
+	goals.Add(6, NewLookAroundGoal())
+	targets.Add(1, NewHurtByTargetGoal())
+	targets.Add(2, NewNearestTargetGoal(16, func(e world.Entity) bool {
+		return isPlayer(e) && !s.bright()
+	}))
+	return s
+}
+
+// Hostile returns true if the spider is in the dark or has a target. Spiders in daylight are neutral.
+func (s *Spider) Hostile() bool {
+	return s.Target() != nil || !s.bright()
+}
+
+// bright checks if the spider is in daylight.
+func (s *Spider) bright() bool {
+	w := s.World()
+	return w != nil && s.inDaylight(w)
+}
+
+// calmDown makes the spider occasionally lose its target while it is in daylight, unless it was recently hurt.
+func (s *Spider) calmDown() {
+	if s.Target() != nil && !s.RecentlyHurt(time.Second*5) && s.bright() && rand.Intn(100) == 0 {
+		s.SetTarget(nil)
+	}
+}
+
+// SpiderType is a world.EntityType implementation for Spider.
+type SpiderType struct{}
+
+func (SpiderType) EncodeEntity() string                 { return "minecraft:spider" }
+func (SpiderType) MobCategory() world.MobCategory       { return world.MonsterCategory() }
+func (SpiderType) CanSpawn(cube.Pos, *world.World) bool { return true }
+func (SpiderType) Spawn(pos mgl64.Vec3) world.Entity    { return NewSpider(pos) }
+func (SpiderType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.7, 0, -0.7, 0.7, 0.9, 0.7)
+}
+
+func (SpiderType) DecodeNBT(m map[string]any) world.Entity {
+	s := NewSpider(nbtconv.Vec3(m, "Pos"))
+	decodeMobNBT(m, s.Mob)
+	return s
+}
+
+func (SpiderType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Spider).Mob)
+}
@@ -10,6 +10,13 @@ import (
 	"time"
 )
 
+// extinguishable represents a block that can be extinguished by a splash water potion, such as a campfire.
+type extinguishable interface {
+	// Extinguish extinguishes the block at the position passed. False is returned if the block could not be
+	// extinguished.
+	Extinguish(pos cube.Pos, w *world.World) bool
+}
+
 // potionSplash returns a function that creates a potion splash with a specific
 // duration multiplier and potion type.
 func potionSplash(durMul float64, pot potion.Potion, linger bool) func(e *Ent, res trace.Result) {
@@ -67,6 +74,11 @@ func potionSplash(durMul float64, pot potion.Potion, linger bool) func(e *Ent, r
 						w.SetBlock(h, nil, nil)
 					}
 				}
+				for _, p := range []cube.Pos{blockPos, blockPos.Side(cube.FaceDown)} {
+					if b, ok := w.Block(p).(extinguishable); ok {
+						b.Extinguish(p, w)
+					}
+				}
 			case trace.EntityResult:
 				// TODO: Damage endermen, blazes, striders and snow golems when implemented and rehydrate axolotls.
 			}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"math/rand"
+	"time"
+)
+
+// HurtByTargetGoal is a Goal that makes a Mob target the entity that last attacked it.
+type HurtByTargetGoal struct{}
+
+// NewHurtByTargetGoal creates a HurtByTargetGoal.
+func NewHurtByTargetGoal() *HurtByTargetGoal {
+	return &HurtByTargetGoal{}
+}
+
+// Flags ...
+func (*HurtByTargetGoal) Flags() GoalFlag {
+	return GoalFlagTarget
+}
+
+// CanStart ...
+func (*HurtByTargetGoal) CanStart(m *Mob) bool {
+	attacker := m.LastAttacker()
+	return m.RecentlyHurt(time.Second/20) && attacker != m.Target() && validTarget(m, attacker)
+}
+
+// CanContinue ...
+func (*HurtByTargetGoal) CanContinue(m *Mob) bool {
+	return validTarget(m, m.Target())
+}
+
+// Start ...
+func (*HurtByTargetGoal) Start(m *Mob) {
+	m.SetTarget(m.LastAttacker())
+}
+
+// Stop ...
+func (*HurtByTargetGoal) Stop(m *Mob) {
+	m.SetTarget(nil)
+}
+
+// Tick ...
+func (*HurtByTargetGoal) Tick(*Mob) {}
+
+// NearestTargetGoal is a Goal that makes a Mob target the nearest entity around it that matches a filter, such
+// as a zombie targeting the nearest player.
+type NearestTargetGoal struct {
+	distance float64
+	filter   func(e world.Entity) bool
+}
+
+// NewNearestTargetGoal creates a NearestTargetGoal that makes a Mob target the nearest entity at most distance
+// blocks away for which the filter passed returns true.
+func NewNearestTargetGoal(distance float64, filter func(e world.Entity) bool) *NearestTargetGoal {
+	return &NearestTargetGoal{distance: distance, filter: filter}
+}
+
+// NewNearestPlayerTargetGoal creates a NearestTargetGoal that makes a Mob target the nearest player at most
+// distance blocks away.
+func NewNearestPlayerTargetGoal(distance float64) *NearestTargetGoal {
+	return NewNearestTargetGoal(distance, isPlayer)
+}
+
+// Flags ...
+func (*NearestTargetGoal) Flags() GoalFlag {
+	return GoalFlagTarget
+}
+
+// CanStart ...
+func (g *NearestTargetGoal) CanStart(m *Mob) bool {
+	if rand.Intn(10) != 0 {
+		return false
+	}
+	return nearestEntity(m, g.distance, func(e world.Entity) bool {
+		return g.filter(e) && validTarget(m, e)
+	}) != nil
+}
+
+// CanContinue ...
+func (g *NearestTargetGoal) CanContinue(m *Mob) bool {
+	target := m.Target()
+	return validTarget(m, target) && target.Position().Sub(m.Position()).Len() <= g.distance
+}
+
+// Start ...
+func (g *NearestTargetGoal) Start(m *Mob) {
+	m.SetTarget(nearestEntity(m, g.distance, func(e world.Entity) bool {
+		return g.filter(e) && validTarget(m, e)
+	}))
+}
+
+// Stop ...
+func (*NearestTargetGoal) Stop(m *Mob) {
+	m.SetTarget(nil)
+}
+
+// Tick ...
+func (*NearestTargetGoal) Tick(*Mob) {}
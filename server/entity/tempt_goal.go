@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// TemptGoal is a Goal that makes a Mob follow players that hold an item that tempts it, such as a cow following
+// a player holding wheat.
+type TemptGoal struct {
+	speed  float64
+	tempt  func(it world.Item) bool
+	player world.Entity
+	repath int
+}
+
+// NewTemptGoal creates a TemptGoal that makes a Mob follow players holding an item for which the function
+// passed returns true, at the multiple of its speed passed.
+func NewTemptGoal(speed float64, tempt func(it world.Item) bool) *TemptGoal {
+	return &TemptGoal{speed: speed, tempt: tempt}
+}
+
+// Flags ...
+func (*TemptGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook
+}
+
+// CanStart ...
+func (g *TemptGoal) CanStart(m *Mob) bool {
+	g.player = nearestEntity(m, 10, func(e world.Entity) bool {
+		return isPlayer(e) && g.tempted(e)
+	})
+	return g.player != nil
+}
+
+// CanContinue ...
+func (g *TemptGoal) CanContinue(m *Mob) bool {
+	p := g.player
+	return p.World() == m.World() && g.tempted(p) && p.Position().Sub(m.Position()).Len() <= 10
+}
+
+// tempted checks if the entity passed holds an item that tempts the Mob in one of its hands.
+func (g *TemptGoal) tempted(e world.Entity) bool {
+	c, ok := e.(item.Carrier)
+	if !ok {
+		return false
+	}
+	if l, ok := e.(Living); ok && l.Dead() {
+		return false
+	}
+	mainHand, offHand := c.HeldItems()
+	return (!mainHand.Empty() && g.tempt(mainHand.Item())) || (!offHand.Empty() && g.tempt(offHand.Item()))
+}
+
+// Start ...
+func (g *TemptGoal) Start(*Mob) {
+	g.repath = 0
+}
+
+// Stop ...
+func (g *TemptGoal) Stop(m *Mob) {
+	g.player = nil
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *TemptGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.player))
+	if g.player.Position().Sub(m.Position()).Len() < 2.5 {
+		m.StopNavigating()
+		return
+	}
+	if g.repath--; g.repath <= 0 {
+		g.repath = 10
+		m.Navigate(g.player.Position(), g.speed)
+	}
+}
@@ -13,9 +13,17 @@ import (
 
 // NewTNT creates a new primed TNT entity.
 func NewTNT(pos mgl64.Vec3, fuse time.Duration, igniter world.Entity) *Ent {
+	return NewTNTWithExplosion(pos, fuse, igniter, block.ExplosionConfig{})
+}
+
+// NewTNTWithExplosion creates a new primed TNT entity that explodes using the block.ExplosionConfig passed. To
+// change the explosions of all TNT in a world, for example to prevent TNT from destroying blocks, the TNT
+// function of the world.EntityRegistryConfig used to create the world's world.EntityRegistry may be set to a
+// function that calls NewTNTWithExplosion.
+func NewTNTWithExplosion(pos mgl64.Vec3, fuse time.Duration, igniter world.Entity, conf block.ExplosionConfig) *Ent {
 	config := tntConf
 	config.ExistenceDuration = fuse
-	ent := Config{Behaviour: config.New()}.New(TNTType{igniter: igniter}, pos)
+	ent := Config{Behaviour: config.New()}.New(TNTType{igniter: igniter, explosion: conf}, pos)
 
 	angle := rand.Float64() * math.Pi * 2
 	ent.vel = mgl64.Vec3{-math.Sin(angle) * 0.02, 0.1, -math.Cos(angle) * 0.02}
@@ -30,13 +38,13 @@ var tntConf = PassiveBehaviourConfig{
 
 // explodeTNT creates an explosion at the position of e.
 func explodeTNT(e *Ent) {
-	var config block.ExplosionConfig
-	config.Explode(e.World(), e.Position())
+	e.t.(TNTType).explosion.Explode(e.World(), e.Position())
 }
 
 // TNTType is a world.EntityType implementation for TNT.
 type TNTType struct {
-	igniter world.Entity
+	igniter   world.Entity
+	explosion block.ExplosionConfig
 }
 
 // Igniter returns the entity that ignited the TNT.
@@ -49,17 +57,34 @@ func (TNTType) BBox(world.Entity) cube.BBox {
 	return cube.Box(-0.49, 0, -0.49, 0.49, 0.98, 0.49)
 }
 
+// DecodeNBT decodes TNT from the NBT passed. The explosion settings of the TNT are restored if they were saved,
+// apart from the Rand, Sound and Particle of the block.ExplosionConfig, which cannot be saved and are taken from
+// the TNTType instead.
 func (t TNTType) DecodeNBT(m map[string]any) world.Entity {
-	tnt := NewTNT(nbtconv.Vec3(m, "Pos"), nbtconv.TickDuration[uint8](m, "Fuse"), t.igniter)
+	conf := t.explosion
+	if _, ok := m["ExplosionSize"]; ok {
+		conf.Size = float64(nbtconv.Float32(m, "ExplosionSize"))
+		conf.SpawnFire = nbtconv.Bool(m, "ExplosionSpawnFire")
+		conf.DisableItemDrops = nbtconv.Bool(m, "ExplosionDisableItemDrops")
+		conf.DisableBlockDamage = nbtconv.Bool(m, "ExplosionDisableBlockDamage")
+		conf.DisableEntityDamage = nbtconv.Bool(m, "ExplosionDisableEntityDamage")
+	}
+	tnt := NewTNTWithExplosion(nbtconv.Vec3(m, "Pos"), nbtconv.TickDuration[uint8](m, "Fuse"), t.igniter, conf)
 	tnt.vel = nbtconv.Vec3(m, "Motion")
 	return tnt
 }
 
 func (TNTType) EncodeNBT(e world.Entity) map[string]any {
 	t := e.(*Ent)
+	conf := t.t.(TNTType).explosion
 	return map[string]any{
-		"Pos":    nbtconv.Vec3ToFloat32Slice(t.Position()),
-		"Motion": nbtconv.Vec3ToFloat32Slice(t.Velocity()),
-		"Fuse":   uint8(t.Behaviour().(*PassiveBehaviour).Fuse().Milliseconds() / 50),
+		"Pos":                          nbtconv.Vec3ToFloat32Slice(t.Position()),
+		"Motion":                       nbtconv.Vec3ToFloat32Slice(t.Velocity()),
+		"Fuse":                         uint8(t.Behaviour().(*PassiveBehaviour).Fuse().Milliseconds() / 50),
+		"ExplosionSize":                float32(conf.Size),
+		"ExplosionSpawnFire":           boolByte(conf.SpawnFire),
+		"ExplosionDisableItemDrops":    boolByte(conf.DisableItemDrops),
+		"ExplosionDisableBlockDamage":  boolByte(conf.DisableBlockDamage),
+		"ExplosionDisableEntityDamage": boolByte(conf.DisableEntityDamage),
 	}
 }
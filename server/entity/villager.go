@@ -0,0 +1,495 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/trade"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"slices"
+	"sync"
+)
+
+const (
+	// villagerRestockTicks is the minimum amount of ticks between two restocks of the offers of a villager.
+	villagerRestockTicks = 6000
+	// workstationRadius is the horizontal radius in blocks in which a villager searches for a workstation.
+	workstationRadius = 12
+)
+
+// Villager is a passive mob that trades with players. A villager obtains a Profession by claiming a nearby
+// workstation block, after which it offers trades of that profession. Villagers unlock new offers as they gain
+// trade experience and restock their offers when working at their workstation.
+type Villager struct {
+	*Mob
+
+	vmu         sync.Mutex
+	profession  Profession
+	workstation cube.Pos
+	employed    bool
+	xp          int
+	offers      []trade.Offer
+	trading     world.Entity
+	restock     int
+}
+
+// tradeOpener is implemented by entities, such as players, that may open a trade window.
+type tradeOpener interface {
+	OpenTrade(w trade.Window)
+}
+
+// tradeUpdater is implemented by entities, such as players, that may have the offers of their opened trade window
+// updated.
+type tradeUpdater interface {
+	UpdateTrade(tier int, offers []trade.Offer)
+}
+
+// villagerPathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every villager.
+var villagerPathfinder = pathfind.Config{Width: 0.6, Height: 1.9, CanSwim: true, CanOpenDoors: true}
+
+// NewVillager creates a new Villager without a profession at the position passed.
+func NewVillager(pos mgl64.Vec3) *Villager {
+	v := &Villager{}
+	v.Mob = MobConfig{
+		MaxHealth:  20,
+		Speed:      0.3,
+		EyeHeight:  1.62,
+		Pathfinder: villagerPathfinder.New(),
+		Tick: func(*Mob) {
+			v.vmu.Lock()
+			v.restock++
+			v.vmu.Unlock()
+		},
+	}.new(VillagerType{}, pos, v)
+
+	goals := v.Goals()
+	goals.Add(0, NewFloatGoal())
+	goals.Add(1, &tradeGoal{})
+	goals.Add(1, NewFleeGoal(1.2, 8, func(e world.Entity) bool {
+		_, ok := e.(*Zombie)
+		return ok
+	}))
+	goals.Add(2, NewPanicGoal(1.5))
+	goals.Add(3, &workstationGoal{})
+	goals.Add(6, NewWanderGoal(0.8))
+	goals.Add(7, NewLookAtPlayerGoal(8))
+	goals.Add(8, NewLookAroundGoal())
+	return v
+}
+
+// NewVillagerWithProfession creates a new Villager with the Profession passed at the position passed. The
+// villager offers the novice trades of the profession right away and only claims workstations of its profession.
+func NewVillagerWithProfession(pos mgl64.Vec3, p Profession) *Villager {
+	v := NewVillager(pos)
+	v.SetProfession(p)
+	return v
+}
+
+// Profession returns the current Profession of the villager.
+func (v *Villager) Profession() Profession {
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	return v.profession
+}
+
+// SetProfession changes the Profession of the villager. The trade experience and offers of the villager are reset
+// and the novice offers of the new profession are unlocked.
+func (v *Villager) SetProfession(p Profession) {
+	v.vmu.Lock()
+	v.profession, v.xp, v.offers = p, 0, nil
+	if p == NoProfession() {
+		v.employed = false
+	}
+	v.unlock(0)
+	v.vmu.Unlock()
+	v.viewState(v.Position())
+}
+
+// Workstation returns the position of the workstation claimed by the villager. False is returned if the villager
+// has not claimed a workstation.
+func (v *Villager) Workstation() (cube.Pos, bool) {
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	return v.workstation, v.employed
+}
+
+// Variant returns the variant of the villager, which decides the profession shown client-side.
+func (v *Villager) Variant() int32 {
+	return int32(v.Profession().Uint8())
+}
+
+// TradeTier returns the current tier of the villager, ranging from 0 (novice) to 4 (master).
+func (v *Villager) TradeTier() int {
+	return trade.TierFromExperience(v.TradeExperience())
+}
+
+// TradeExperience returns the trade experience of the villager.
+func (v *Villager) TradeExperience() int {
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	return v.xp
+}
+
+// Offers returns the offers that the villager currently has unlocked.
+func (v *Villager) Offers() []trade.Offer {
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	return slices.Clone(v.offers)
+}
+
+// Interact opens the trade window of the villager for the user if the villager has a profession.
+func (v *Villager) Interact(user item.User, _ *item.UseContext) bool {
+	o, ok := user.(tradeOpener)
+	if !ok || v.Dead() {
+		return false
+	}
+	v.vmu.Lock()
+	if v.profession == NoProfession() || v.trading != nil {
+		v.vmu.Unlock()
+		return false
+	}
+	v.trading = user
+	w := trade.Window{
+		Name:   v.profession.Name(),
+		Entity: v,
+		Tier:   trade.TierFromExperience(v.xp),
+		Offers: slices.Clone(v.offers),
+		Trade:  v.trade,
+		Close:  v.closeTrade,
+	}
+	v.vmu.Unlock()
+
+	o.OpenTrade(w)
+	return true
+}
+
+// trade handles the trading of the offer at the index passed. The uses of the offer are increased and the
+// villager gains trade experience, unlocking new offers when it reaches a new tier. The new offers are sent to
+// the trade window of the user.
+func (v *Villager) trade(u item.User, index int) {
+	v.vmu.Lock()
+	if index >= len(v.offers) {
+		v.vmu.Unlock()
+		return
+	}
+	v.offers[index].Uses++
+	tier := trade.TierFromExperience(v.xp)
+	v.xp += v.offers[index].Experience
+	newTier := trade.TierFromExperience(v.xp)
+	for t := tier + 1; t <= newTier; t++ {
+		v.unlock(t)
+	}
+	offers := slices.Clone(v.offers)
+	v.vmu.Unlock()
+
+	if newTier > tier {
+		if updater, ok := u.(tradeUpdater); ok {
+			updater.UpdateTrade(newTier, offers)
+		}
+	}
+	v.viewState(v.Position())
+}
+
+// closeTrade is called when the player that the villager is trading with closes the trade window.
+func (v *Villager) closeTrade(item.User) {
+	v.vmu.Lock()
+	v.trading = nil
+	v.vmu.Unlock()
+}
+
+// unlock unlocks two random offers of the tier passed. unlock must be called while v.vmu is locked.
+func (v *Villager) unlock(tier int) {
+	trades, ok := villagerTrades[v.profession]
+	if !ok {
+		return
+	}
+	for _, i := range rand.Perm(len(trades[tier]))[:min(2, len(trades[tier]))] {
+		offer := trades[tier][i]
+		offer.Tier = tier
+		v.offers = append(v.offers, offer)
+	}
+}
+
+// restockOffers resets the uses of all offers of the villager if it has not restocked recently. True is returned
+// if the offers were restocked.
+func (v *Villager) restockOffers() bool {
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	if v.restock < villagerRestockTicks || !slices.ContainsFunc(v.offers, func(o trade.Offer) bool { return o.Uses > 0 }) {
+		return false
+	}
+	v.restock = 0
+	for i := range v.offers {
+		v.offers[i].Uses = 0
+	}
+	return true
+}
+
+// claim makes the villager claim the workstation at the position passed. If the villager does not have a
+// profession, it obtains the profession of the workstation.
+func (v *Villager) claim(pos cube.Pos, p Profession) {
+	v.vmu.Lock()
+	v.workstation, v.employed = pos, true
+	changed := v.profession != p
+	v.vmu.Unlock()
+	if changed {
+		v.SetProfession(p)
+	}
+}
+
+// release makes the villager release its workstation. If the villager has never traded, it also loses its
+// profession.
+func (v *Villager) release() {
+	v.vmu.Lock()
+	v.employed = false
+	reset := v.xp == 0
+	v.vmu.Unlock()
+	if reset {
+		v.SetProfession(NoProfession())
+	}
+}
+
+// claimed checks if the workstation at the position passed was claimed by any villager in the world other than
+// the villager passed.
+func claimed(w *world.World, pos cube.Pos, self *Villager) bool {
+	for _, e := range w.Entities() {
+		if other, ok := e.(*Villager); ok && other != self {
+			if p, ok := other.Workstation(); ok && p == pos {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tradeGoal is a Goal that makes a Villager stand still and look at the player it is trading with.
+type tradeGoal struct{}
+
+// Flags ...
+func (*tradeGoal) Flags() GoalFlag {
+	return GoalFlagMove | GoalFlagLook | GoalFlagJump
+}
+
+// CanStart ...
+func (*tradeGoal) CanStart(m *Mob) bool {
+	v, ok := m.Entity().(*Villager)
+	if !ok {
+		return false
+	}
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	if v.trading == nil {
+		return false
+	}
+	if v.trading.World() != m.World() || v.trading.Position().Sub(m.Position()).Len() > 16 {
+		// The player left without closing the trade window, so we stop trading with it.
+		v.trading = nil
+		return false
+	}
+	return true
+}
+
+// CanContinue ...
+func (g *tradeGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (*tradeGoal) Start(m *Mob) {
+	m.StopNavigating()
+}
+
+// Stop ...
+func (*tradeGoal) Stop(*Mob) {}
+
+// Tick ...
+func (*tradeGoal) Tick(m *Mob) {
+	v := m.Entity().(*Villager)
+	v.vmu.Lock()
+	trader := v.trading
+	v.vmu.Unlock()
+	if trader != nil {
+		m.LookAt(EyePosition(trader))
+	}
+}
+
+// workstationGoal is a Goal that makes a Villager search for and claim a workstation. A Villager that has claimed
+// a workstation walks to it every now and then to restock its offers.
+type workstationGoal struct {
+	cooldown, ticks int
+	target          cube.Pos
+	profession      Profession
+}
+
+// Flags ...
+func (*workstationGoal) Flags() GoalFlag {
+	return GoalFlagMove
+}
+
+// CanStart ...
+func (g *workstationGoal) CanStart(m *Mob) bool {
+	if g.cooldown--; g.cooldown > 0 {
+		return false
+	}
+	g.cooldown = 100 + rand.Intn(100)
+	v, ok := m.Entity().(*Villager)
+	if !ok {
+		return false
+	}
+	w := m.World()
+	if pos, ok := v.Workstation(); ok {
+		if !v.Profession().Workstation(w.Block(pos)) {
+			v.release()
+			return false
+		}
+		v.vmu.Lock()
+		restock := v.restock >= villagerRestockTicks
+		v.vmu.Unlock()
+		g.target, g.profession = pos, v.Profession()
+		return restock
+	}
+	return g.search(v, w)
+}
+
+// search searches the area around the villager for a workstation that has not yet been claimed. True is returned
+// if one was found.
+func (g *workstationGoal) search(v *Villager, w *world.World) bool {
+	current := v.Profession()
+	centre := cube.PosFromVec3(v.Position())
+	for y := -3; y <= 3; y++ {
+		for x := -workstationRadius; x <= workstationRadius; x++ {
+			for z := -workstationRadius; z <= workstationRadius; z++ {
+				pos := centre.Add(cube.Pos{x, y, z})
+				p, ok := professionByWorkstation(w.Block(pos))
+				if !ok || (current != NoProfession() && p != current) || claimed(w, pos, v) {
+					continue
+				}
+				g.target, g.profession = pos, p
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CanContinue ...
+func (g *workstationGoal) CanContinue(m *Mob) bool {
+	return g.ticks > 0 && m.Navigating()
+}
+
+// Start ...
+func (g *workstationGoal) Start(m *Mob) {
+	g.ticks = 200
+	m.Navigate(g.target.Vec3Centre(), 0.8)
+}
+
+// Stop ...
+func (g *workstationGoal) Stop(m *Mob) {
+	g.ticks = 0
+	m.StopNavigating()
+}
+
+// Tick ...
+func (g *workstationGoal) Tick(m *Mob) {
+	g.ticks--
+	if m.Position().Sub(g.target.Vec3Centre()).Len() > 2.5 {
+		return
+	}
+	v := m.Entity().(*Villager)
+	w := m.World()
+	if _, ok := v.Workstation(); !ok {
+		if !g.profession.Workstation(w.Block(g.target)) || claimed(w, g.target, v) {
+			g.ticks = 0
+			return
+		}
+		v.claim(g.target, g.profession)
+	}
+	v.restockOffers()
+	m.LookAt(g.target.Vec3Centre())
+	g.ticks = 0
+}
+
+// VillagerType is a world.EntityType implementation for Villager.
+type VillagerType struct{}
+
+func (VillagerType) EncodeEntity() string { return "minecraft:villager_v2" }
+func (VillagerType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.9, 0.3)
+}
+
+func (VillagerType) DecodeNBT(m map[string]any) world.Entity {
+	v := NewVillager(nbtconv.Vec3(m, "Pos"))
+	decodeMobNBT(m, v.Mob)
+	name := nbtconv.String(m, "PreferredProfession")
+	for _, p := range Professions() {
+		if p.String() == name {
+			v.profession = p
+		}
+	}
+	v.xp = int(nbtconv.Int32(m, "TradeExperience"))
+	if _, ok := m["Workstation"]; ok {
+		v.workstation, v.employed = nbtconv.Pos(m, "Workstation"), true
+	}
+	if offers, ok := m["Offers"].(map[string]any); ok {
+		for _, r := range nbtconv.Slice(offers, "Recipes") {
+			if data, ok := r.(map[string]any); ok {
+				v.offers = append(v.offers, decodeOffer(data))
+			}
+		}
+	}
+	return v
+}
+
+func (VillagerType) EncodeNBT(e world.Entity) map[string]any {
+	v := e.(*Villager)
+	data := encodeMobNBT(v.Mob)
+
+	v.vmu.Lock()
+	defer v.vmu.Unlock()
+	recipes := make([]any, 0, len(v.offers))
+	for _, o := range v.offers {
+		recipes = append(recipes, encodeOffer(o))
+	}
+	data["PreferredProfession"] = v.profession.String()
+	data["TradeExperience"] = int32(v.xp)
+	data["TradeTier"] = int32(trade.TierFromExperience(v.xp))
+	data["Offers"] = map[string]any{"Recipes": recipes}
+	if v.employed {
+		data["Workstation"] = nbtconv.PosToInt32Slice(v.workstation)
+	}
+	return data
+}
+
+// encodeOffer encodes a trade.Offer to a map that can be encoded to NBT.
+func encodeOffer(o trade.Offer) map[string]any {
+	data := map[string]any{
+		"buyA":      nbtconv.WriteItem(o.Input, true),
+		"sell":      nbtconv.WriteItem(o.Output, true),
+		"uses":      int32(o.Uses),
+		"maxUses":   int32(o.MaxUses),
+		"tier":      int32(o.Tier),
+		"traderExp": int32(o.Experience),
+		"rewardExp": boolByte(o.RewardExperience),
+	}
+	if !o.SecondInput.Empty() {
+		data["buyB"] = nbtconv.WriteItem(o.SecondInput, true)
+	}
+	return data
+}
+
+// decodeOffer decodes a trade.Offer from the data passed.
+func decodeOffer(data map[string]any) trade.Offer {
+	return trade.Offer{
+		Input:            nbtconv.MapItem(data, "buyA"),
+		SecondInput:      nbtconv.MapItem(data, "buyB"),
+		Output:           nbtconv.MapItem(data, "sell"),
+		Uses:             int(nbtconv.Int32(data, "uses")),
+		MaxUses:          int(nbtconv.Int32(data, "maxUses")),
+		Tier:             int(nbtconv.Int32(data, "tier")),
+		Experience:       int(nbtconv.Int32(data, "traderExp")),
+		RewardExperience: nbtconv.Bool(data, "rewardExp"),
+	}
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/trade"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// villagerTrades holds the offers that a Villager of each Profession may unlock, indexed by the tier at which they
+// are unlocked. Two random offers are unlocked from the offers of a tier every time a villager reaches that tier.
+var villagerTrades = map[Profession][5][]trade.Offer{
+	Farmer(): {
+		{buy(item.Wheat{}, 20, 16, 2), buy(block.Potato{}, 26, 16, 2), buy(block.Carrot{}, 22, 16, 2), buy(item.Beetroot{}, 15, 16, 2), sell(1, item.Bread{}, 6, 16, 1)},
+		{buy(block.Pumpkin{}, 6, 12, 10), sell(1, item.PumpkinPie{}, 4, 12, 5), sell(1, item.Apple{}, 4, 16, 5)},
+		{sell(3, item.Cookie{}, 18, 12, 10), buy(block.Melon{}, 4, 12, 20)},
+		{sell(1, block.Cake{}, 1, 12, 15)},
+		{sell(3, item.GoldenCarrot{}, 3, 12, 30), sell(4, item.GlisteringMelonSlice{}, 3, 12, 30)},
+	},
+	Fisherman(): {
+		{buy(item.String{}, 20, 16, 2), buy(item.Coal{}, 10, 16, 2), exchange(1, item.Cod{}, item.Cod{Cooked: true}, 6, 16, 1)},
+		{buy(item.Cod{}, 15, 16, 10), exchange(1, item.Salmon{}, item.Salmon{Cooked: true}, 6, 16, 5), sell(2, block.Campfire{}, 1, 12, 5)},
+		{buy(item.Salmon{}, 13, 16, 20)},
+		{buy(item.TropicalFish{}, 6, 12, 30)},
+		{buy(item.Pufferfish{}, 4, 12, 30)},
+	},
+	Shepherd(): {
+		{buy(block.Wool{Colour: item.ColourWhite()}, 18, 16, 2), buy(block.Wool{Colour: item.ColourBrown()}, 18, 16, 2), sell(2, item.Shears{}, 1, 12, 1)},
+		{buy(item.Dye{Colour: item.ColourBlack()}, 12, 16, 10), buy(item.Dye{Colour: item.ColourGrey()}, 12, 16, 10), sell(1, block.Wool{Colour: item.ColourWhite()}, 1, 16, 5), sell(1, block.Carpet{Colour: item.ColourWhite()}, 4, 16, 5)},
+		{buy(item.Dye{Colour: item.ColourLightBlue()}, 12, 16, 20), buy(item.Dye{Colour: item.ColourLime()}, 12, 16, 20), sell(3, block.Bed{Colour: item.ColourWhite()}, 1, 12, 10)},
+		{buy(item.Dye{Colour: item.ColourOrange()}, 12, 16, 30), buy(item.Dye{Colour: item.ColourPink()}, 12, 16, 30), sell(3, block.Banner{Colour: item.ColourWhite()}, 1, 12, 15)},
+		{sell(2, block.Carpet{Colour: item.ColourRed()}, 4, 12, 30), sell(2, block.Carpet{Colour: item.ColourBlue()}, 4, 12, 30)},
+	},
+	Fletcher(): {
+		{buy(item.Stick{}, 32, 16, 2), sell(1, item.Arrow{}, 16, 12, 1), exchange(1, block.Gravel{}, item.Flint{}, 10, 12, 1)},
+		{buy(item.Flint{}, 26, 12, 10), sell(2, item.Bow{}, 1, 12, 5)},
+		{buy(item.String{}, 14, 16, 20)},
+		{buy(item.Feather{}, 24, 16, 30)},
+		{sell(3, item.Bow{}, 1, 12, 30)},
+	},
+	Librarian(): {
+		{buy(item.Paper{}, 24, 16, 2), sell(9, block.Bookshelf{}, 1, 12, 1)},
+		{buy(item.Book{}, 4, 12, 10), sell(1, block.Lantern{Type: block.NormalFire()}, 1, 12, 5)},
+		{buy(item.InkSac{}, 5, 12, 20), sell(1, block.Glass{}, 4, 12, 10)},
+		{buy(item.BookAndQuill{}, 2, 12, 30), sell(5, item.Clock{}, 1, 12, 15), sell(4, item.Compass{}, 1, 12, 15)},
+		{sell(20, block.Bookshelf{}, 3, 12, 30)},
+	},
+	Armourer(): {
+		{buy(item.Coal{}, 15, 16, 2), sell(7, item.Leggings{Tier: item.ArmourTierIron{}}, 1, 12, 1), sell(4, item.Boots{Tier: item.ArmourTierIron{}}, 1, 12, 1), sell(5, item.Helmet{Tier: item.ArmourTierIron{}}, 1, 12, 1), sell(9, item.Chestplate{Tier: item.ArmourTierIron{}}, 1, 12, 1)},
+		{buy(item.IronIngot{}, 4, 12, 10)},
+		{buy(item.Diamond{}, 1, 12, 20)},
+		{sell(19, item.Leggings{Tier: item.ArmourTierDiamond{}}, 1, 3, 15), sell(13, item.Boots{Tier: item.ArmourTierDiamond{}}, 1, 3, 15)},
+		{sell(13, item.Helmet{Tier: item.ArmourTierDiamond{}}, 1, 3, 30), sell(21, item.Chestplate{Tier: item.ArmourTierDiamond{}}, 1, 3, 30)},
+	},
+	Weaponsmith(): {
+		{buy(item.Coal{}, 15, 16, 2), sell(3, item.Axe{Tier: item.ToolTierIron}, 1, 12, 1), sell(7, item.Sword{Tier: item.ToolTierIron}, 1, 12, 1)},
+		{buy(item.IronIngot{}, 4, 12, 10)},
+		{buy(item.Flint{}, 24, 12, 20)},
+		{buy(item.Diamond{}, 1, 12, 30), sell(17, item.Axe{Tier: item.ToolTierDiamond}, 1, 3, 15)},
+		{sell(13, item.Sword{Tier: item.ToolTierDiamond}, 1, 3, 30)},
+	},
+	Toolsmith(): {
+		{buy(item.Coal{}, 15, 16, 2), sell(1, item.Axe{Tier: item.ToolTierStone}, 1, 12, 1), sell(1, item.Shovel{Tier: item.ToolTierStone}, 1, 12, 1), sell(1, item.Pickaxe{Tier: item.ToolTierStone}, 1, 12, 1), sell(1, item.Hoe{Tier: item.ToolTierStone}, 1, 12, 1)},
+		{buy(item.IronIngot{}, 4, 12, 10)},
+		{buy(item.Flint{}, 30, 12, 20), sell(2, item.Shovel{Tier: item.ToolTierIron}, 1, 3, 10), sell(4, item.Hoe{Tier: item.ToolTierDiamond}, 1, 3, 10)},
+		{buy(item.Diamond{}, 1, 12, 30), sell(12, item.Axe{Tier: item.ToolTierDiamond}, 1, 3, 15), sell(5, item.Shovel{Tier: item.ToolTierDiamond}, 1, 3, 15)},
+		{sell(13, item.Pickaxe{Tier: item.ToolTierDiamond}, 1, 3, 30)},
+	},
+	Butcher(): {
+		{buy(item.Chicken{}, 14, 16, 2), buy(item.Porkchop{}, 7, 16, 2), buy(item.Rabbit{}, 4, 16, 2), sell(1, item.RabbitStew{}, 1, 12, 1)},
+		{buy(item.Coal{}, 15, 16, 10), sell(1, item.Porkchop{Cooked: true}, 5, 16, 5), sell(1, item.Chicken{Cooked: true}, 8, 16, 5)},
+		{buy(item.Mutton{}, 7, 16, 20), buy(item.Beef{}, 10, 16, 20)},
+		{buy(block.DriedKelp{}, 10, 12, 30)},
+		{sell(1, item.Beef{Cooked: true}, 5, 12, 30)},
+	},
+	Mason(): {
+		{buy(item.ClayBall{}, 10, 16, 2), sell(1, item.Brick{}, 10, 16, 1)},
+		{buy(block.Stone{}, 20, 16, 10), sell(1, block.StoneBricks{Type: block.ChiseledStoneBricks()}, 4, 16, 5)},
+		{buy(block.Granite{}, 16, 16, 20), buy(block.Andesite{}, 16, 16, 20), buy(block.Diorite{}, 16, 16, 20), sell(1, block.Andesite{Polished: true}, 4, 16, 10)},
+		{buy(item.NetherQuartz{}, 12, 12, 30), sell(1, block.Terracotta{}, 1, 12, 15), sell(1, block.GlazedTerracotta{Colour: item.ColourWhite()}, 1, 12, 15)},
+		{sell(1, block.QuartzPillar{}, 1, 12, 30), sell(1, block.Quartz{}, 1, 12, 30)},
+	},
+}
+
+// buy returns an offer in which a villager buys count of the item passed for an emerald.
+func buy(it world.Item, count, maxUses, xp int) trade.Offer {
+	return trade.Offer{Input: item.NewStack(it, count), Output: item.NewStack(item.Emerald{}, 1), MaxUses: maxUses, Experience: xp, RewardExperience: true}
+}
+
+// sell returns an offer in which a villager sells count of the item passed for the amount of emeralds passed.
+func sell(emeralds int, it world.Item, count, maxUses, xp int) trade.Offer {
+	return trade.Offer{Input: item.NewStack(item.Emerald{}, emeralds), Output: item.NewStack(it, count), MaxUses: maxUses, Experience: xp, RewardExperience: true}
+}
+
+// exchange returns an offer in which a villager exchanges count of the item passed for count of the item
+// returned, in addition to the amount of emeralds passed.
+func exchange(emeralds int, it, returned world.Item, count, maxUses, xp int) trade.Offer {
+	return trade.Offer{Input: item.NewStack(item.Emerald{}, emeralds), SecondInput: item.NewStack(it, count), Output: item.NewStack(returned, count), MaxUses: maxUses, Experience: xp, RewardExperience: true}
+}
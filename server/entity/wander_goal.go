@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// WanderGoal is a Goal that makes a Mob occasionally walk to a random position near it.
+type WanderGoal struct {
+	speed    float64
+	interval int
+}
+
+// NewWanderGoal creates a WanderGoal that makes a Mob wander at the multiple of its speed passed. The Mob
+// starts wandering on average once every 120 ticks.
+func NewWanderGoal(speed float64) *WanderGoal {
+	return &WanderGoal{speed: speed, interval: 120}
+}
+
+// Flags ...
+func (*WanderGoal) Flags() GoalFlag {
+	return GoalFlagMove
+}
+
+// CanStart ...
+func (g *WanderGoal) CanStart(m *Mob) bool {
+	return !m.Navigating() && rand.Intn(g.interval) == 0
+}
+
+// CanContinue ...
+func (*WanderGoal) CanContinue(m *Mob) bool {
+	return m.Navigating()
+}
+
+// Start ...
+func (g *WanderGoal) Start(m *Mob) {
+	if pos, ok := randomPositionNear(m, 10, 7, nil); ok {
+		m.Navigate(pos, g.speed)
+	}
+}
+
+// Stop ...
+func (*WanderGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// Tick ...
+func (*WanderGoal) Tick(*Mob) {}
+
+// randomPositionNear returns a random position that the Mob passed can stand at, at most horizontal blocks away
+// horizontally and vertical blocks away vertically. If away is not nil, only positions that are further away
+// from it than the Mob are returned. False is returned if no such position could be found.
+func randomPositionNear(m *Mob, horizontal, vertical int, away *mgl64.Vec3) (mgl64.Vec3, bool) {
+	w, origin := m.World(), cube.PosFromVec3(m.Position())
+	for i := 0; i < 10; i++ {
+		pos := origin.Add(cube.Pos{
+			rand.Intn(horizontal*2+1) - horizontal,
+			rand.Intn(vertical*2+1) - vertical,
+			rand.Intn(horizontal*2+1) - horizontal,
+		})
+		if away != nil && pos.Vec3Centre().Sub(*away).Len() <= m.Position().Sub(*away).Len() {
+			continue
+		}
+		for y := 0; y < vertical; y++ {
+			if standable(w, pos) {
+				return pos.Vec3Middle(), true
+			}
+			pos = pos.Side(cube.FaceDown)
+		}
+	}
+	return mgl64.Vec3{}, false
+}
+
+// standable checks if an entity is able to stand at the position passed, meaning the block below it has a solid
+// top face and the position and the block above it are free.
+func standable(w *world.World, pos cube.Pos) bool {
+	if pos.OutOfBounds(w.Range()) {
+		return false
+	}
+	below := pos.Side(cube.FaceDown)
+	if !w.Block(below).Model().FaceSolid(below, cube.FaceUp, w) {
+		return false
+	}
+	for _, p := range []cube.Pos{pos, pos.Side(cube.FaceUp)} {
+		if len(w.Block(p).Model().BBox(p, w)) != 0 {
+			return false
+		}
+		if l, ok := w.Liquid(p); ok {
+			if _, lava := l.(block.Lava); lava {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/pathfind"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Zombie is a common undead Monster that chases and attacks players. Zombies burn in daylight.
+type Zombie struct {
+	*Monster
+}
+
+// zombiePathfinder is the pathfind.Config used to create the pathfind.Pathfinder of every zombie.
+var zombiePathfinder = pathfind.Config{Width: 0.6, Height: 1.95, CanSwim: true}
+
+// NewZombie creates a new Zombie at the position passed.
+func NewZombie(pos mgl64.Vec3) *Zombie {
+	z := &Zombie{}
+	z.Monster = newMonster(MobConfig{
+		Speed:        0.23,
+		AttackDamage: 3,
+		EyeHeight:    1.62,
+		Pathfinder:   zombiePathfinder.New(),
+		Drops: func(m *Mob, _ world.DamageSource) []item.Stack {
+			drops := []item.Stack{item.NewStack(item.RottenFlesh{}, rand.Intn(3))}
+			if m.killedByPlayer() && rand.Intn(40) == 0 {
+				drops = append(drops, item.NewStack([]world.Item{item.IronIngot{}, block.Carrot{}, block.Potato{}}[rand.Intn(3)], 1))
+			}
+			return drops
+		},
+		Experience: monsterExperience,
+	}, ZombieType{}, pos, z, true)
+
+	goals, targets := z.Goals(), z.Targets()
+	goals.Add(0, NewFloatGoal())
+	goals.Add(2, NewMeleeAttackGoal(1))
+	goals.Add(7, NewWanderGoal(1))
+	goals.Add(8, NewLookAtPlayerGoal(8))
+	goals.Add(8, NewLookAroundGoal())
+	targets.Add(1, NewHurtByTargetGoal())
+	targets.Add(2, NewNearestPlayerTargetGoal(35))
+	return z
+}
+
+// ZombieType is a world.EntityType implementation for Zombie.
+type ZombieType struct{}
+
+func (ZombieType) EncodeEntity() string                 { return "minecraft:zombie" }
+func (ZombieType) MobCategory() world.MobCategory       { return world.MonsterCategory() }
+func (ZombieType) CanSpawn(cube.Pos, *world.World) bool { return true }
+func (ZombieType) Spawn(pos mgl64.Vec3) world.Entity    { return NewZombie(pos) }
+func (ZombieType) BBox(world.Entity) cube.BBox {
+	return cube.Box(-0.3, 0, -0.3, 0.3, 1.95, 0.3)
+}
+
+func (ZombieType) DecodeNBT(m map[string]any) world.Entity {
+	z := NewZombie(nbtconv.Vec3(m, "Pos"))
+	decodeMobNBT(m, z.Mob)
+	return z
+}
+
+func (ZombieType) EncodeNBT(e world.Entity) map[string]any {
+	return encodeMobNBT(e.(*Zombie).Mob)
+}
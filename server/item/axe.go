@@ -15,8 +15,22 @@ type Axe struct {
 	Tier ToolTier
 }
 
-// UseOnBlock handles the stripping of logs when a player clicks a log with an axe.
+// UseOnBlock handles the stripping of logs when a player clicks a log with an axe, and the scraping of wax and
+// oxidation off copper blocks.
 func (a Axe) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	if s, ok := w.Block(pos).(scrapable); ok {
+		if res, waxRemoved, ok := s.Scrape(); ok {
+			w.SetBlock(pos, res, nil)
+			if waxRemoved {
+				w.PlaySound(pos.Vec3(), sound.WaxRemoved{})
+			} else {
+				w.PlaySound(pos.Vec3(), sound.CopperScraped{})
+			}
+
+			ctx.DamageItem(1)
+			return true
+		}
+	}
 	if s, ok := w.Block(pos).(strippable); ok {
 		if res, ok := s.Strip(); ok {
 			w.SetBlock(pos, res, nil)
@@ -36,6 +50,13 @@ type strippable interface {
 	Strip() (world.Block, bool)
 }
 
+// scrapable represents a block that may be scraped using an axe, such as copper.
+type scrapable interface {
+	// Scrape returns the block that is the result of scraping it. The first bool returned specifies if wax was
+	// scraped off the block, as opposed to oxidation. The second bool is false if the block could not be scraped.
+	Scrape() (world.Block, bool, bool)
+}
+
 // MaxCount always returns 1.
 func (a Axe) MaxCount() int {
 	return 1
@@ -3,6 +3,7 @@ package item
 import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/potion"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
 	"math"
 	"time"
@@ -95,6 +96,7 @@ func (Bow) Release(releaser Releaser, duration time.Duration, ctx *UseContext) {
 
 	releaser.PlaySound(sound.BowShoot{})
 	releaser.World().AddEntity(projectile)
+	releaser.World().EmitGameEvent(releaser.Position(), world.GameEventProjectileShoot(), releaser)
 }
 
 // EnchantmentValue ...
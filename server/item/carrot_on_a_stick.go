@@ -1,5 +1,7 @@
 package item
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // CarrotOnAStick is an item that can be used to control saddled pigs.
 type CarrotOnAStick struct{}
 
@@ -8,6 +10,37 @@ func (CarrotOnAStick) MaxCount() int {
 	return 1
 }
 
+// DurabilityInfo ...
+func (CarrotOnAStick) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 26,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// boostable represents a vehicle that may be boosted by its rider using a carrot on a stick, such as a pig.
+type boostable interface {
+	// Boost boosts the speed of the vehicle. False is returned if the vehicle could not be boosted.
+	Boost() bool
+}
+
+// Use boosts the vehicle ridden by the user if it is a pig.
+func (CarrotOnAStick) Use(_ *world.World, user User, ctx *UseContext) bool {
+	r, ok := user.(world.Rider)
+	if !ok {
+		return false
+	}
+	v, ok := r.Vehicle()
+	if !ok {
+		return false
+	}
+	if b, ok := v.(boostable); ok && b.Boost() {
+		ctx.DamageItem(7)
+		return true
+	}
+	return false
+}
+
 // EncodeItem ...
 func (CarrotOnAStick) EncodeItem() (name string, meta int16) {
 	return "minecraft:carrot_on_a_stick", 0
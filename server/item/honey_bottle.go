@@ -0,0 +1,38 @@
+package item
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/effect"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+	"time"
+)
+
+// HoneyBottle is a drinkable item obtained by using a glass bottle on a full beehive or bee nest. Drinking it restores
+// hunger and cures poison.
+type HoneyBottle struct{}
+
+// MaxCount ...
+func (HoneyBottle) MaxCount() int {
+	return 16
+}
+
+// AlwaysConsumable ...
+func (HoneyBottle) AlwaysConsumable() bool {
+	return true
+}
+
+// ConsumeDuration ...
+func (HoneyBottle) ConsumeDuration() time.Duration {
+	return time.Second * 2
+}
+
+// Consume ...
+func (HoneyBottle) Consume(_ *world.World, c Consumer) Stack {
+	c.Saturate(6, 1.2)
+	c.RemoveEffect(effect.Poison{})
+	return NewStack(GlassBottle{}, 1)
+}
+
+// EncodeItem ...
+func (HoneyBottle) EncodeItem() (name string, meta int16) {
+	return "minecraft:honey_bottle", 0
+}
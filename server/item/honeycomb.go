@@ -10,8 +10,8 @@ import (
 // Honeycomb is an item obtained from bee nests and beehives.
 type Honeycomb struct{}
 
-// UseOnBlock handles the logic of using an ink sac on a sign. Glowing ink sacs turn the text of these signs glowing,
-// whereas normal ink sacs revert them back to non-glowing text.
+// UseOnBlock handles the logic of using a honeycomb on a block that may be waxed, such as signs and copper. Waxed
+// signs can no longer be edited, whereas waxed copper no longer oxidises.
 func (Honeycomb) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.World, user User, ctx *UseContext) bool {
 	if wa, ok := w.Block(pos).(waxable); ok {
 		if res, ok := wa.Wax(pos, user.Position()); ok {
@@ -26,7 +26,7 @@ func (Honeycomb) UseOnBlock(pos cube.Pos, _ cube.Face, _ mgl64.Vec3, w *world.Wo
 
 // waxable represents a block that may be waxed.
 type waxable interface {
-	// Wax uses an ink sac on the block, returning the resulting block and a bool specifying if waxing the block was
+	// Wax uses a honeycomb on the block, returning the resulting block and a bool specifying if waxing the block was
 	// successful.
 	Wax(pos cube.Pos, userPos mgl64.Vec3) (world.Block, bool)
 }
@@ -0,0 +1,269 @@
+package recipe
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/potion"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Potion is a recipe that may be brewed in a brewing stand. It turns an input potion into a different potion of
+// the same kind using a reagent.
+type Potion struct {
+	recipe
+}
+
+// NewPotion creates a new potion recipe and returns it. The input and output are potions, such as item.Potion or
+// item.SplashPotion, while the reagent is the item put in the ingredient slot of the brewing stand.
+func NewPotion(input, reagent Item, output item.Stack, block string) Potion {
+	return Potion{recipe: recipe{
+		input:  []Item{input, reagent},
+		output: []item.Stack{output},
+		block:  block,
+	}}
+}
+
+// PotionContainerChange is a recipe that may be brewed in a brewing stand. It changes the kind of potion, such as
+// turning a drinkable potion into a splash potion, while keeping the type of the potion.
+type PotionContainerChange struct {
+	recipe
+}
+
+// NewPotionContainerChange creates a new potion container change recipe and returns it. Potions of any type of
+// the input item are turned into potions of the same type of the output item when brewed with the reagent.
+func NewPotionContainerChange(input world.Item, reagent Item, output world.Item, block string) PotionContainerChange {
+	return PotionContainerChange{recipe: recipe{
+		input:  []Item{item.NewStack(input, 1), reagent},
+		output: []item.Stack{item.NewStack(output, 1)},
+		block:  block,
+	}}
+}
+
+// Brew looks for a recipe brewed on the block passed that turns the potion passed into a different potion using
+// the reagent passed. If found, the brewed potion is returned and the bool returned is true.
+func Brew(block string, input, reagent item.Stack) (item.Stack, bool) {
+	if input.Empty() || reagent.Empty() {
+		return item.Stack{}, false
+	}
+	vanillaRecipes()
+
+	name, meta := input.Item().EncodeItem()
+	reagentName, _ := reagent.Item().EncodeItem()
+	for _, index := range [...]*brewingIndex{&vanillaBrewing, &brewing} {
+		// PotionContainerChange recipes accept potions of any type and are indexed with a metadata value of -1.
+		for _, m := range [...]int16{meta, -1} {
+			for _, r := range index.recipes[brewingKey{block: block, input: name, meta: m, reagent: reagentName}] {
+				if !matchesItem(r.Input()[1], reagent) {
+					continue
+				}
+				switch r := r.(type) {
+				case Potion:
+					return r.Output()[0], true
+				case PotionContainerChange:
+					outputName, _ := r.Output()[0].Item().EncodeItem()
+					if it, ok := world.ItemByName(outputName, meta); ok {
+						return item.NewStack(it, 1), true
+					}
+				}
+			}
+		}
+	}
+	return item.Stack{}, false
+}
+
+// Reagent checks if the item passed is used as a reagent in any of the brewing recipes brewed on the block passed.
+func Reagent(block string, reagent item.Stack) bool {
+	if reagent.Empty() {
+		return false
+	}
+	vanillaRecipes()
+
+	name, meta := reagent.Item().EncodeItem()
+	for _, index := range [...]*brewingIndex{&vanillaBrewing, &brewing} {
+		// Reagents that are item tags match items of any metadata value and are indexed with a metadata value of -1.
+		for _, m := range [...]int16{meta, -1} {
+			if _, ok := index.reagents[brewingKey{block: block, reagent: name, meta: m}]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	// vanillaBrewing indexes the vanilla brewing recipes. It is filled when the vanilla recipes are loaded.
+	vanillaBrewing brewingIndex
+	// brewing indexes the brewing recipes registered using Register.
+	brewing brewingIndex
+)
+
+// brewingKey is the key by which brewing recipes are indexed: The block they are brewed on, the name and metadata
+// value of their input and the name of their reagent.
+type brewingKey struct {
+	block, input, reagent string
+	meta                  int16
+}
+
+// brewingIndex indexes Potion and PotionContainerChange recipes, so that the recipes for an input and reagent are
+// found without going over every registered recipe.
+type brewingIndex struct {
+	// recipes holds the recipes by the block they are brewed on, their input and their reagent.
+	recipes map[brewingKey][]Recipe
+	// reagents holds the reagents of the recipes by the block they are brewed on and the name and metadata value
+	// of the reagent.
+	reagents map[brewingKey]struct{}
+}
+
+// add adds the recipe passed to the index if it is a Potion or PotionContainerChange recipe.
+func (index *brewingIndex) add(r Recipe) {
+	switch r.(type) {
+	case Potion, PotionContainerChange:
+	default:
+		return
+	}
+	if index.recipes == nil {
+		index.recipes, index.reagents = make(map[brewingKey][]Recipe), make(map[brewingKey]struct{})
+	}
+	input, reagent := r.Input()[0].(item.Stack), r.Input()[1]
+	name, meta := input.Item().EncodeItem()
+	if _, ok := r.(PotionContainerChange); ok {
+		meta = -1
+	}
+	var (
+		reagentNames []string
+		reagentMeta  int16 = -1
+	)
+	switch i := reagent.(type) {
+	case item.Stack:
+		var reagentName string
+		reagentName, reagentMeta = i.Item().EncodeItem()
+		reagentNames = []string{reagentName}
+	case ItemTag:
+		reagentNames = i.items
+	}
+	for _, reagentName := range reagentNames {
+		k := brewingKey{block: r.Block(), input: name, meta: meta, reagent: reagentName}
+		index.recipes[k] = append(index.recipes[k], r)
+		index.reagents[brewingKey{block: r.Block(), reagent: reagentName, meta: reagentMeta}] = struct{}{}
+	}
+}
+
+// matchesItem checks if the recipe Item passed matches the item.Stack passed.
+func matchesItem(i Item, s item.Stack) bool {
+	switch i := i.(type) {
+	case item.Stack:
+		return sameItem(i, s)
+	case ItemTag:
+		name, _ := s.Item().EncodeItem()
+		return i.Contains(name)
+	}
+	return false
+}
+
+// sameItem checks if the two item stacks passed hold the same item.
+func sameItem(a, b item.Stack) bool {
+	if a.Empty() || b.Empty() {
+		return false
+	}
+	name, meta := a.Item().EncodeItem()
+	name2, meta2 := b.Item().EncodeItem()
+	return name == name2 && meta == meta2
+}
+
+// registerVanillaBrewing registers all vanilla brewing recipes.
+func registerVanillaBrewing() {
+	const block = "brewing_stand"
+	var (
+		redstone, glowstone = item.RedstoneDust{}, item.GlowstoneDust{}
+		fermentedSpiderEye  = item.FermentedSpiderEye{}
+	)
+	type brew struct {
+		input   potion.Potion
+		reagent world.Item
+		output  potion.Potion
+	}
+	brews := []brew{
+		{potion.Water(), redstone, potion.Mundane()},
+		{potion.Water(), glowstone, potion.Thick()},
+		{potion.Water(), item.Sugar{}, potion.Mundane()},
+		{potion.Water(), item.GhastTear{}, potion.Mundane()},
+		{potion.Water(), item.RabbitFoot{}, potion.Mundane()},
+		{potion.Water(), item.BlazePowder{}, potion.Mundane()},
+		{potion.Water(), item.GlisteringMelonSlice{}, potion.Mundane()},
+		{potion.Water(), item.SpiderEye{}, potion.Mundane()},
+		{potion.Water(), item.MagmaCream{}, potion.Mundane()},
+		{potion.Water(), fermentedSpiderEye, potion.Weakness()},
+		{potion.Mundane(), redstone, potion.LongMundane()},
+
+		{potion.Awkward(), item.GoldenCarrot{}, potion.NightVision()},
+		{potion.NightVision(), redstone, potion.LongNightVision()},
+		{potion.NightVision(), fermentedSpiderEye, potion.Invisibility()},
+		{potion.LongNightVision(), fermentedSpiderEye, potion.LongInvisibility()},
+		{potion.Invisibility(), redstone, potion.LongInvisibility()},
+
+		{potion.Awkward(), item.RabbitFoot{}, potion.Leaping()},
+		{potion.Leaping(), redstone, potion.LongLeaping()},
+		{potion.Leaping(), glowstone, potion.StrongLeaping()},
+		{potion.Leaping(), fermentedSpiderEye, potion.Slowness()},
+		{potion.LongLeaping(), fermentedSpiderEye, potion.LongSlowness()},
+
+		{potion.Awkward(), item.MagmaCream{}, potion.FireResistance()},
+		{potion.FireResistance(), redstone, potion.LongFireResistance()},
+
+		{potion.Awkward(), item.Sugar{}, potion.Swiftness()},
+		{potion.Swiftness(), redstone, potion.LongSwiftness()},
+		{potion.Swiftness(), glowstone, potion.StrongSwiftness()},
+		{potion.Swiftness(), fermentedSpiderEye, potion.Slowness()},
+		{potion.LongSwiftness(), fermentedSpiderEye, potion.LongSlowness()},
+		{potion.Slowness(), redstone, potion.LongSlowness()},
+		{potion.Slowness(), glowstone, potion.StrongSlowness()},
+
+		{potion.Awkward(), item.Pufferfish{}, potion.WaterBreathing()},
+		{potion.WaterBreathing(), redstone, potion.LongWaterBreathing()},
+
+		{potion.Awkward(), item.GlisteringMelonSlice{}, potion.Healing()},
+		{potion.Healing(), glowstone, potion.StrongHealing()},
+		{potion.Healing(), fermentedSpiderEye, potion.Harming()},
+		{potion.StrongHealing(), fermentedSpiderEye, potion.StrongHarming()},
+		{potion.Harming(), glowstone, potion.StrongHarming()},
+
+		{potion.Awkward(), item.SpiderEye{}, potion.Poison()},
+		{potion.Poison(), redstone, potion.LongPoison()},
+		{potion.Poison(), glowstone, potion.StrongPoison()},
+		{potion.Poison(), fermentedSpiderEye, potion.Harming()},
+		{potion.LongPoison(), fermentedSpiderEye, potion.Harming()},
+		{potion.StrongPoison(), fermentedSpiderEye, potion.StrongHarming()},
+
+		{potion.Awkward(), item.GhastTear{}, potion.Regeneration()},
+		{potion.Regeneration(), redstone, potion.LongRegeneration()},
+		{potion.Regeneration(), glowstone, potion.StrongRegeneration()},
+
+		{potion.Awkward(), item.BlazePowder{}, potion.Strength()},
+		{potion.Strength(), redstone, potion.LongStrength()},
+		{potion.Strength(), glowstone, potion.StrongStrength()},
+
+		{potion.Weakness(), redstone, potion.LongWeakness()},
+
+		{potion.Awkward(), item.TurtleShell{}, potion.TurtleMaster()},
+		{potion.TurtleMaster(), redstone, potion.LongTurtleMaster()},
+		{potion.TurtleMaster(), glowstone, potion.StrongTurtleMaster()},
+
+		{potion.Awkward(), item.PhantomMembrane{}, potion.SlowFalling()},
+		{potion.SlowFalling(), redstone, potion.LongSlowFalling()},
+	}
+	if netherWart, ok := world.ItemByName("minecraft:nether_wart", 0); ok {
+		brews = append(brews, brew{potion.Water(), netherWart, potion.Awkward()})
+	}
+
+	kinds := []func(t potion.Potion) world.Item{
+		func(t potion.Potion) world.Item { return item.Potion{Type: t} },
+		func(t potion.Potion) world.Item { return item.SplashPotion{Type: t} },
+		func(t potion.Potion) world.Item { return item.LingeringPotion{Type: t} },
+	}
+	for _, kind := range kinds {
+		for _, r := range brews {
+			registerVanillaRecipe(NewPotion(item.NewStack(kind(r.input), 1), item.NewStack(r.reagent, 1), item.NewStack(kind(r.output), 1), block))
+		}
+	}
+	registerVanillaRecipe(NewPotionContainerChange(item.Potion{}, item.NewStack(item.Gunpowder{}, 1), item.SplashPotion{}, block))
+	registerVanillaRecipe(NewPotionContainerChange(item.SplashPotion{}, item.NewStack(item.DragonBreath{}, 1), item.LingeringPotion{}, block))
+}
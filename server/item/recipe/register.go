@@ -2,17 +2,38 @@ package recipe
 
 import (
 	"slices"
+	"sync"
 )
 
-// recipes is a list of each recipe.
-var recipes []Recipe
+var (
+	// recipes is a list of each recipe registered using Register.
+	recipes []Recipe
+	// vanilla is a list of each vanilla recipe. These recipes are loaded once they are first needed.
+	vanilla     []Recipe
+	vanillaOnce sync.Once
+)
 
-// Recipes returns each recipe in a slice.
+// Recipes returns each recipe in a slice. Vanilla recipes are always first in the slice, followed by the recipes
+// registered using Register.
 func Recipes() []Recipe {
-	return slices.Clone(recipes)
+	return append(slices.Clone(vanillaRecipes()), recipes...)
 }
 
 // Register registers a new recipe.
 func Register(recipe Recipe) {
 	recipes = append(recipes, recipe)
+	brewing.add(recipe)
+}
+
+// vanillaRecipes returns all vanilla recipes, loading them if they were not yet loaded. The slice returned must
+// not be modified.
+func vanillaRecipes() []Recipe {
+	vanillaOnce.Do(registerVanilla)
+	return vanilla
+}
+
+// registerVanillaRecipe registers a vanilla recipe.
+func registerVanillaRecipe(recipe Recipe) {
+	vanilla = append(vanilla, recipe)
+	vanillaBrewing.add(recipe)
 }
@@ -2,9 +2,6 @@ package recipe
 
 import (
 	_ "embed"
-	// Ensure all blocks and items are registered before trying to load vanilla recipes.
-	_ "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block"
-	_ "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
 	"github.com/Adrian8115/gophertunnel-Amethyst-Protocol/minecraft/nbt"
 )
 
@@ -35,7 +32,10 @@ type shapelessRecipe struct {
 	Priority int32       `nbt:"priority"`
 }
 
-func init() {
+// registerVanilla registers all vanilla recipes. It is called when the recipes are first needed rather than in
+// an init function, because the block package, which must have registered all of its blocks before the vanilla
+// recipes can be loaded, depends on this package.
+func registerVanilla() {
 	var craftingRecipes struct {
 		Shaped    []shapedRecipe    `nbt:"shaped"`
 		Shapeless []shapelessRecipe `nbt:"shapeless"`
@@ -51,7 +51,7 @@ func init() {
 			// This can be expected to happen, as some recipes contain blocks or items that aren't currently implemented.
 			continue
 		}
-		Register(Shapeless{recipe{
+		registerVanillaRecipe(Shapeless{recipe{
 			input:    input,
 			output:   output,
 			block:    s.Block,
@@ -66,7 +66,7 @@ func init() {
 			// This can be expected to happen - refer to the comment above.
 			continue
 		}
-		Register(Shaped{
+		registerVanillaRecipe(Shaped{
 			shape: Shape{int(s.Width), int(s.Height)},
 			recipe: recipe{
 				input:    input,
@@ -89,7 +89,7 @@ func init() {
 			// This can be expected to happen - refer to the comment above.
 			continue
 		}
-		Register(SmithingTransform{recipe{
+		registerVanillaRecipe(SmithingTransform{recipe{
 			input:    input,
 			output:   output,
 			block:    s.Block,
@@ -108,10 +108,12 @@ func init() {
 			// This can be expected to happen - refer to the comment above.
 			continue
 		}
-		Register(SmithingTrim{recipe{
+		registerVanillaRecipe(SmithingTrim{recipe{
 			input:    input,
 			block:    s.Block,
 			priority: uint32(s.Priority),
 		}})
 	}
+
+	registerVanillaBrewing()
 }
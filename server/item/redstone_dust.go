@@ -0,0 +1,9 @@
+package item
+
+// RedstoneDust is a mineral obtained from redstone ore. It is used in brewing to extend the duration of potions.
+type RedstoneDust struct{}
+
+// EncodeItem ...
+func (RedstoneDust) EncodeItem() (name string, meta int16) {
+	return "minecraft:redstone", 0
+}
@@ -67,6 +67,7 @@ func init() {
 	world.RegisterItem(GoldenCarrot{})
 	world.RegisterItem(Gunpowder{})
 	world.RegisterItem(HeartOfTheSea{})
+	world.RegisterItem(HoneyBottle{})
 	world.RegisterItem(Honeycomb{})
 	world.RegisterItem(InkSac{Glowing: true})
 	world.RegisterItem(InkSac{})
@@ -104,7 +105,9 @@ func init() {
 	world.RegisterItem(RawGold{})
 	world.RegisterItem(RawIron{})
 	world.RegisterItem(RecoveryCompass{})
+	world.RegisterItem(RedstoneDust{})
 	world.RegisterItem(RottenFlesh{})
+	world.RegisterItem(Saddle{})
 	world.RegisterItem(Salmon{Cooked: true})
 	world.RegisterItem(Salmon{})
 	world.RegisterItem(Scute{})
@@ -115,6 +118,7 @@ func init() {
 	world.RegisterItem(SpiderEye{})
 	world.RegisterItem(Spyglass{})
 	world.RegisterItem(Stick{})
+	world.RegisterItem(String{})
 	world.RegisterItem(Sugar{})
 	world.RegisterItem(Totem{})
 	world.RegisterItem(TropicalFish{})
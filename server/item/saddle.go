@@ -0,0 +1,14 @@
+package item
+
+// Saddle is an item that can be put on pigs to allow players to ride them.
+type Saddle struct{}
+
+// MaxCount ...
+func (Saddle) MaxCount() int {
+	return 1
+}
+
+// EncodeItem ...
+func (Saddle) EncodeItem() (name string, meta int16) {
+	return "minecraft:saddle", 0
+}
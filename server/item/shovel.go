@@ -15,8 +15,13 @@ type Shovel struct {
 	Tier ToolTier
 }
 
-// UseOnBlock handles the creation of dirt path blocks from dirt or grass blocks.
+// UseOnBlock handles the creation of dirt path blocks from dirt or grass blocks and the extinguishing of
+// campfires.
 func (s Shovel) UseOnBlock(pos cube.Pos, face cube.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	if b, ok := w.Block(pos).(extinguishable); ok && b.Extinguish(pos, w) {
+		ctx.DamageItem(1)
+		return true
+	}
 	if b, ok := w.Block(pos).(shovellable); ok {
 		if res, ok := b.Shovel(); ok {
 			if face == cube.FaceDown {
@@ -44,6 +49,13 @@ type shovellable interface {
 	Shovel() (world.Block, bool)
 }
 
+// extinguishable represents a block that can be extinguished by using a shovel on it, such as a campfire.
+type extinguishable interface {
+	// Extinguish extinguishes the block at the position passed. False is returned if the block could not be
+	// extinguished.
+	Extinguish(pos cube.Pos, w *world.World) bool
+}
+
 // MaxCount always returns 1.
 func (s Shovel) MaxCount() int {
 	return 1
@@ -0,0 +1,9 @@
+package item
+
+// String is an item dropped by spiders that is used to craft bows, fishing rods and wool.
+type String struct{}
+
+// EncodeItem ...
+func (String) EncodeItem() (name string, meta int16) {
+	return "minecraft:string", 0
+}
@@ -0,0 +1,78 @@
+package trade
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+)
+
+// Offer is a single offer in a trade Window. A player may trade the inputs of the offer for its output as long as
+// the offer is not disabled.
+type Offer struct {
+	// Input is the first item that must be paid for the offer. Its count is the amount that must be paid.
+	Input item.Stack
+	// SecondInput is an optional second item that must be paid for the offer. If empty, only Input must be paid.
+	SecondInput item.Stack
+	// Output is the item that is received when trading.
+	Output item.Stack
+	// Uses is the amount of times that the offer has been traded.
+	Uses int
+	// MaxUses is the amount of times that the offer may be traded before it is disabled. If 0, the offer may be
+	// traded an unlimited amount of times.
+	MaxUses int
+	// Tier is the tier that a trader must have for the offer to be available, ranging from 0 (novice) to 4
+	// (master).
+	Tier int
+	// Experience is the amount of trade experience that the trader gains when the offer is traded.
+	Experience int
+	// RewardExperience specifies if the player that trades the offer is rewarded with experience.
+	RewardExperience bool
+}
+
+// NewOffer creates a new Offer that trades the input stack passed for the output stack passed. The offer may be
+// traded an unlimited amount of times.
+func NewOffer(input, output item.Stack) Offer {
+	return Offer{Input: input, Output: output}
+}
+
+// Disabled checks if the offer may no longer be traded because it has reached its maximum amount of uses.
+func (o Offer) Disabled() bool {
+	return o.MaxUses > 0 && o.Uses >= o.MaxUses
+}
+
+// Window is a trade window that may be opened for a player. It holds the offers that the player may trade and is
+// used both for villagers and for custom trade windows, such as those of NPC shops.
+type Window struct {
+	// Name is the name displayed at the top of the window.
+	Name string
+	// Entity is the entity that the player trades with. The model of this entity is displayed in the window. If
+	// nil, the model of the player itself is displayed.
+	Entity world.Entity
+	// Tier is the tier of the trader, ranging from 0 (novice) to 4 (master). Only offers with a Tier lower than or
+	// equal to this tier are available.
+	Tier int
+	// Offers holds the offers that may be traded in the window.
+	Offers []Offer
+	// Trade is called when the user passed trades the offer at the index passed. It may be nil.
+	Trade func(u item.User, index int)
+	// Close is called when the user passed closes the window. It may be nil.
+	Close func(u item.User)
+}
+
+// tierExperience holds the trade experience required for each tier.
+var tierExperience = [...]int{0, 10, 70, 150, 250}
+
+// TierExperience returns the trade experience required for a trader to reach the tier passed. TierExperience
+// panics if the tier is not in the range 0-4.
+func TierExperience(tier int) int {
+	return tierExperience[tier]
+}
+
+// TierFromExperience returns the tier of a trader with the trade experience passed.
+func TierFromExperience(xp int) int {
+	for tier := len(tierExperience) - 1; tier > 0; tier-- {
+		if xp >= tierExperience[tier] {
+			return tier
+		}
+	}
+	return 0
+}
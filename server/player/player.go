@@ -20,6 +20,7 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/enchantment"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/trade"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/player/bossbar"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/player/chat"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/player/form"
@@ -69,6 +70,7 @@ type Player struct {
 	glideTicks   atomic.Int64
 	fireTicks    atomic.Int64
 	fallDistance atomic.Float64
+	stepDistance atomic.Float64
 
 	breathing         bool
 	airSupplyTicks    atomic.Int64
@@ -103,6 +105,15 @@ type Player struct {
 
 	breakParticleCounter atomic.Uint32
 
+	sleeping atomic.Bool
+	sleepPos atomic.Value[cube.Pos]
+	// spawnBlock holds the position of the bed or respawn anchor that the spawn point of the player was last set
+	// with, if any.
+	spawnBlock atomic.Value[*cube.Pos]
+
+	vehicleMu sync.Mutex
+	vehicle   world.Vehicle
+
 	hunger *hungerManager
 }
 
@@ -276,6 +287,13 @@ func (p *Player) Messagef(f string, a ...any) {
 	p.session().SendMessage(fmt.Sprintf(f, a...))
 }
 
+// SendTranslation sends a message to the player that is translated by the client into the language it has
+// selected. The key passed is the translation key of the message, such as 'tile.bed.noSleep', and the parameters
+// passed are filled into the translated message.
+func (p *Player) SendTranslation(key string, a ...string) {
+	p.session().SendTranslation(key, a...)
+}
+
 // SendPopup sends a formatted popup to the player. The popup is shown above the hotbar of the player and
 // overwrites/is overwritten by the name of the item equipped.
 // The popup is formatted following the rules of fmt.Sprintln without a newline at the end.
@@ -417,6 +435,19 @@ func (p *Player) SendForm(f form.Form) {
 	p.session().SendForm(f)
 }
 
+// OpenTrade opens the trade window passed for the player, allowing the player to trade the offers of the window.
+// Any container that the player had opened is closed. OpenTrade may be used to open custom trade windows, such
+// as those of NPC shops.
+func (p *Player) OpenTrade(w trade.Window) {
+	p.session().OpenTrade(w)
+}
+
+// UpdateTrade updates the tier and offers of the trade window that the player currently has opened. Nothing
+// happens if the player has no trade window opened.
+func (p *Player) UpdateTrade(tier int, offers []trade.Offer) {
+	p.session().UpdateTrade(tier, offers)
+}
+
 // ShowCoordinates enables the vanilla coordinates for the player.
 func (p *Player) ShowCoordinates() {
 	p.session().EnableCoordinates(true)
@@ -544,6 +575,9 @@ func (p *Player) fall(distance float64) {
 	if h, ok := b.(block.EntityLander); ok {
 		h.EntityLand(pos, w, p, &distance)
 	}
+	if distance >= 1 {
+		w.EmitGameEvent(p.Position(), world.GameEventHitGround(), p)
+	}
 	dmg := distance - 3
 	if boost, ok := p.Effect(effect.JumpBoost{}); ok {
 		dmg -= float64(boost.Level())
@@ -602,6 +636,7 @@ func (p *Player) Hurt(dmg float64, src world.DamageSource) (float64, bool) {
 		}
 	}
 
+	p.Wake()
 	p.addHealth(-damageLeft)
 
 	if src.ReducedByArmour() {
@@ -674,7 +709,9 @@ func (p *Player) FinalDamageFrom(dmg float64, src world.DamageSource) float64 {
 // Explode ...
 func (p *Player) Explode(explosionPos mgl64.Vec3, impact float64, c block.ExplosionConfig) {
 	diff := p.Position().Sub(explosionPos)
-	p.Hurt(math.Floor((impact*impact+impact)*3.5*c.Size+1), entity.ExplosionDamageSource{})
+	if !c.DisableEntityDamage {
+		p.Hurt(math.Floor((impact*impact+impact)*3.5*c.Size+1), entity.ExplosionDamageSource{})
+	}
 	p.knockBack(explosionPos, impact, diff[1]/diff.Len()*impact)
 }
 
@@ -852,8 +889,10 @@ func (p *Player) kill(src world.DamageSource) {
 	p.Handler().HandleDeath(src, &keepInv)
 	p.StopSneaking()
 	p.StopSprinting()
+	p.Dismount()
 
 	w, pos := p.World(), p.Position()
+	w.EmitGameEvent(pos, world.GameEventEntityDie(), p)
 	if !keepInv {
 		p.dropContents()
 	}
@@ -918,7 +957,20 @@ func (p *Player) Respawn() {
 	// We can use the principle here that returning through a portal of a specific dimension inside that dimension will
 	// always bring us back to the overworld.
 	w = w.PortalDestination(w.Dimension())
-	pos := w.PlayerSpawn(p.UUID()).Vec3Middle()
+	spawn := w.PlayerSpawn(p.UUID())
+	pos := spawn.Vec3Middle()
+	if _, ok := w.Block(spawn).(block.Bed); ok {
+		// Spawn the player on top of the bed, rather than inside of it.
+		pos = pos.Add(mgl64.Vec3{0, 0.5625})
+	} else if nether, anchorPos, ok := p.respawnAnchor(w); ok {
+		w, pos = nether, anchorPos.Side(cube.FaceUp).Vec3Middle()
+	} else if b := p.spawnBlock.Load(); b != nil && *b == spawn {
+		// The bed or respawn anchor that the spawn point was set with no longer exists, so the spawn point is reset
+		// to the spawn of the world. Spawn points set in any other way, such as by plugins, are left alone.
+		p.spawnBlock.Store(nil)
+		w.SetPlayerSpawn(p.UUID(), w.Spawn())
+		pos = w.Spawn().Vec3Middle()
+	}
 
 	p.Handler().HandleRespawn(&pos, &w)
 
@@ -929,6 +981,71 @@ func (p *Player) Respawn() {
 	p.SetVisible()
 }
 
+// respawnAnchor looks for a charged respawn anchor at the spawn point of the player in the Nether World that
+// the overworld passed leads to. If found, one charge of the respawn anchor is consumed and the Nether World and
+// the position of the respawn anchor are returned.
+func (p *Player) respawnAnchor(overworld *world.World) (*world.World, cube.Pos, bool) {
+	nether := overworld.PortalDestination(world.Nether)
+	if nether == nil || nether.Dimension() != world.Nether {
+		return nil, cube.Pos{}, false
+	}
+	pos := nether.PlayerSpawn(p.UUID())
+	anchor, ok := nether.Block(pos).(block.RespawnAnchor)
+	if !ok || anchor.Charge == 0 {
+		return nil, cube.Pos{}, false
+	}
+	anchor.Charge--
+	nether.SetBlock(pos, anchor, nil)
+	nether.PlaySound(pos.Vec3Centre(), sound.RespawnAnchorDeplete{})
+	return nether, pos, true
+}
+
+// TrackSpawnBlock marks the spawn point of the player as set by the bed or respawn anchor at the position passed.
+// If the spawn point of the player is still at that position when it respawns, but the block is no longer
+// present, the spawn point is reset to the spawn of the world.
+func (p *Player) TrackSpawnBlock(pos cube.Pos) {
+	p.spawnBlock.Store(&pos)
+}
+
+// Sleep makes the player start sleeping in the bed at the position passed. The player is moved onto the bed and
+// the client shows the sleeping animation. Sleep does nothing if the player is already sleeping.
+func (p *Player) Sleep(pos cube.Pos) {
+	if !p.sleeping.CAS(false, true) {
+		return
+	}
+	p.StopSneaking()
+	p.StopSprinting()
+	p.sleepPos.Store(pos)
+	p.pos.Store(pos.Vec3Middle().Add(mgl64.Vec3{0, 0.5625}))
+	p.vel.Store(mgl64.Vec3{})
+	p.updateState()
+}
+
+// Sleeping returns the position of the bed the player is sleeping in and true if the player is currently
+// sleeping.
+func (p *Player) Sleeping() (cube.Pos, bool) {
+	if !p.sleeping.Load() {
+		return cube.Pos{}, false
+	}
+	return p.sleepPos.Load(), true
+}
+
+// Wake wakes the player up if it is currently sleeping, freeing up the bed it was sleeping in.
+func (p *Player) Wake() {
+	if !p.sleeping.CAS(true, false) {
+		return
+	}
+	pos, w := p.sleepPos.Load(), p.World()
+	if b, ok := w.Block(pos).(block.Bed); ok && b.Occupied {
+		b.Occupied = false
+		w.SetBlock(pos, b, nil)
+	}
+	for _, v := range p.viewers() {
+		v.ViewEntityAction(p, entity.WakeUpAction{})
+	}
+	p.updateState()
+}
+
 // StartSprinting makes a player start sprinting, increasing the speed of the player by 30% and making
 // particles show up under the feet. The player will only start sprinting if its food level is high enough.
 // If the player is sneaking when calling StartSprinting, it is stopped from sneaking.
@@ -1096,6 +1213,46 @@ func (p *Player) Jump() {
 	}
 }
 
+// Mount makes the player ride the world.Vehicle passed. If the player is already riding a vehicle, it is
+// dismounted from that vehicle first. False is returned if the player could not mount the vehicle, for example
+// because all of its seats are taken.
+func (p *Player) Mount(v world.Vehicle) bool {
+	if p.Dead() {
+		return false
+	}
+	return v.Mount(p)
+}
+
+// Dismount makes the player stop riding the world.Vehicle it is currently riding, if any.
+func (p *Player) Dismount() {
+	if v, ok := p.Vehicle(); ok {
+		v.Dismount(p)
+	}
+}
+
+// Vehicle returns the world.Vehicle that the player is currently riding. False is returned if the player is not
+// riding a vehicle.
+func (p *Player) Vehicle() (world.Vehicle, bool) {
+	p.vehicleMu.Lock()
+	defer p.vehicleMu.Unlock()
+	return p.vehicle, p.vehicle != nil
+}
+
+// SetVehicle sets the world.Vehicle that the player is riding. It is called by the vehicle when the player mounts
+// or dismounts it. Player.Mount and Player.Dismount should be used to make the player ride a vehicle.
+func (p *Player) SetVehicle(v world.Vehicle) {
+	p.vehicleMu.Lock()
+	p.vehicle = v
+	p.vehicleMu.Unlock()
+}
+
+// MoveWithVehicle moves the player to the position of its seat on the vehicle it rides. The movement is not shown
+// to viewers, as they show the player on its seat themselves.
+func (p *Player) MoveWithVehicle(pos mgl64.Vec3) {
+	p.pos.Store(pos)
+	p.fallDistance.Store(0)
+}
+
 // SetInvisible sets the player invisible, so that other players will not be able to see it.
 func (p *Player) SetInvisible() {
 	if !p.invisible.CAS(false, true) {
@@ -1348,6 +1505,7 @@ func (p *Player) UseItem() {
 		useCtx.NewItem = usable.Consume(w, p)
 		p.addNewItem(useCtx)
 		w.PlaySound(p.Position().Add(mgl64.Vec3{0, 1.5}), sound.Burp{})
+		w.EmitGameEvent(p.Position(), world.GameEventEat(), p)
 	}
 }
 
@@ -1480,7 +1638,8 @@ func (p *Player) UseItemOnBlock(pos cube.Pos, face cube.Face, clickPos mgl64.Vec
 
 // UseItemOnEntity uses the item held in the main hand of the player on the entity passed, provided it is
 // within range of the player.
-// If the item held in the main hand of the player does nothing when used on an entity, nothing will happen.
+// If the entity implements entity.Interactable, the entity handles the interaction first. If it does not, and the
+// item held in the main hand of the player does nothing when used on an entity, nothing will happen.
 func (p *Player) UseItemOnEntity(e world.Entity) bool {
 	if !p.canReach(e.Position()) {
 		return false
@@ -1490,13 +1649,13 @@ func (p *Player) UseItemOnEntity(e world.Entity) bool {
 		return false
 	}
 	i, left := p.HeldItems()
-	usable, ok := i.Item().(item.UsableOnEntity)
-	if !ok {
-		return true
-	}
 	useCtx := p.useContext()
-	if !usable.UseOnEntity(e, e.World(), p, useCtx) {
-		return true
+	if in, ok := e.(entity.Interactable); !ok || !in.Interact(p, useCtx) {
+		// The entity did not handle the interaction itself, so try to use the item held on the entity instead.
+		usable, ok := i.Item().(item.UsableOnEntity)
+		if !ok || !usable.UseOnEntity(e, e.World(), p, useCtx) {
+			return true
+		}
 	}
 	p.SwingArm()
 	p.SetHeldItems(p.subtractItem(p.damageItem(i, useCtx.Damage), useCtx.CountSub), left)
@@ -1527,6 +1686,10 @@ func (p *Player) AttackEntity(e world.Entity) bool {
 	p.SwingArm()
 
 	i, _ := p.HeldItems()
+	if a, ok := e.(entity.Attackable); ok {
+		a.Attack(p, i)
+		return true
+	}
 	living, ok := e.(entity.Living)
 	if !ok {
 		return false
@@ -1746,6 +1909,7 @@ func (p *Player) placeBlock(pos cube.Pos, b world.Block, ignoreBBox bool) bool {
 	}
 	w.SetBlock(pos, b, nil)
 	w.PlaySound(pos.Vec3(), sound.BlockPlace{Block: b})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventBlockPlace(), p)
 	p.SwingArm()
 	return true
 }
@@ -1808,6 +1972,7 @@ func (p *Player) BreakBlock(pos cube.Pos) {
 	p.SwingArm()
 	w.SetBlock(pos, nil, nil)
 	w.AddParticle(pos.Vec3Centre(), particle.BlockBreak{Block: b})
+	w.EmitGameEvent(pos.Vec3Centre(), world.GameEventBlockDestroy(), p)
 
 	if breakable, ok := b.(block.Breakable); ok {
 		info := breakable.BreakInfo()
@@ -1841,7 +2006,13 @@ func (p *Player) drops(held item.Stack, b world.Block) []item.Stack {
 		t = item.ToolNone{}
 	}
 	var drops []item.Stack
-	if container, ok := b.(block.Container); ok {
+	if box, ok := b.(block.ShulkerBox); ok {
+		// Shulker boxes keep their contents when broken, so they are dropped as an item holding the contents,
+		// even in creative mode if the shulker box is not empty.
+		if !p.GameMode().CreativeInventory() || !box.Inventory().Empty() {
+			drops = []item.Stack{item.NewStack(box, 1)}
+		}
+	} else if container, ok := b.(block.Container); ok {
 		// If the block is a container, it should drop its inventory contents regardless whether the
 		// player is in creative mode or not.
 		drops = container.Inventory().Items()
@@ -1921,6 +2092,8 @@ func (p *Player) Teleport(pos mgl64.Vec3) {
 	if p.Handler().HandleTeleport(ctx, pos); ctx.Cancelled() {
 		return
 	}
+	p.Wake()
+	p.Dismount()
 	p.teleport(pos)
 }
 
@@ -2002,6 +2175,7 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 
 	p.onGround.Store(p.checkOnGround(w))
 	p.updateFallState(deltaPos[1])
+	p.emitStepEvents(w, horizontalVel.Len())
 
 	if p.Swimming() {
 		p.Exhaust(0.01 * horizontalVel.Len())
@@ -2010,6 +2184,38 @@ func (p *Player) Move(deltaPos mgl64.Vec3, deltaYaw, deltaPitch float64) {
 	}
 }
 
+// emitStepEvents emits a step or swim game event every time the player has moved far enough over the ground or
+// through water. Sneaking or flying players do not cause vibrations, and neither do players walking on blocks that
+// occlude vibrations, such as wool. Blocks that implement block.EntityStepper are notified of every step taken on
+// them.
+func (p *Player) emitStepEvents(w *world.World, distance float64) {
+	if p.Flying() || (!p.OnGround() && !p.Swimming()) {
+		return
+	}
+	if p.stepDistance.Add(distance) < 1.6 {
+		return
+	}
+	p.stepDistance.Store(0)
+	if p.Swimming() {
+		if !p.Sneaking() {
+			w.EmitGameEvent(p.Position(), world.GameEventSwim(), p)
+		}
+		return
+	}
+	pos := cube.PosFromVec3(p.Position()).Side(cube.FaceDown)
+	b := w.Block(pos)
+	if s, ok := b.(block.EntityStepper); ok {
+		s.EntityStepOn(pos, w, p)
+	}
+	if p.Sneaking() {
+		return
+	}
+	if o, ok := b.(world.VibrationOccluder); ok && o.OccludesVibrations() {
+		return
+	}
+	w.EmitGameEvent(p.Position(), world.GameEventStep(), p)
+}
+
 // World returns the world that the player is currently in.
 func (p *Player) World() *world.World {
 	w, _ := world.OfEntity(p)
@@ -2060,6 +2266,9 @@ func (p *Player) Collect(s item.Stack) int {
 		return 0
 	}
 	n, _ := p.Inventory().AddItem(s)
+	if n > 0 {
+		p.World().EmitGameEvent(p.Position(), world.GameEventItemPickup(), p)
+	}
 	return n
 }
 
@@ -2202,6 +2411,14 @@ func (p *Player) Drop(s item.Stack) int {
 	return s.Count()
 }
 
+// OpenEntityContainer opens the inventory of an entity, such as a boat with a chest. OpenEntityContainer does
+// nothing if the entity has no inventory or if the player has no session connected to it.
+func (p *Player) OpenEntityContainer(e world.Entity) {
+	if p.session() != session.Nop {
+		p.session().OpenEntityContainer(e)
+	}
+}
+
 // OpenBlockContainer opens a block container, such as a chest, at the position passed. If no container was
 // present at that location, OpenBlockContainer does nothing.
 // OpenBlockContainer will also do nothing if the player has no session connected to it.
@@ -2247,6 +2464,12 @@ func (p *Player) Tick(w *world.World, current int64) {
 		p.Handler().HandleChangeWorld(p.lastTickedWorld, w)
 	}
 	p.lastTickedWorld = w
+	if pos, ok := p.Sleeping(); ok {
+		if _, ok := w.Block(pos).(block.Bed); !ok {
+			// The bed the player was sleeping in was removed, so it can no longer sleep in it.
+			p.Wake()
+		}
+	}
 	if _, ok := w.Liquid(cube.PosFromVec3(p.Position())); !ok {
 		p.StopSwimming()
 		if _, ok := p.Armour().Helmet().Item().(item.TurtleShell); ok {
@@ -2806,6 +3029,8 @@ func (p *Player) close(msg string) {
 		p.Respawn()
 	}
 	p.h.Swap(NopHandler{}).HandleQuit()
+	p.Wake()
+	p.Dismount()
 
 	if s := p.s.Swap(nil); s != nil {
 		s.Disconnect(msg)
@@ -74,7 +74,12 @@ type Controllable interface {
 	StartGliding()
 	Gliding() bool
 	StopGliding()
+	Sleep(pos cube.Pos)
+	Sleeping() (cube.Pos, bool)
+	Wake()
 	Jump()
+	Vehicle() (world.Vehicle, bool)
+	Dismount()
 
 	StartBreaking(pos cube.Pos, face cube.Face)
 	ContinueBreaking(face cube.Face)
@@ -1,6 +1,7 @@
 package session
 
 import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/effect"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
@@ -53,6 +54,13 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 	if gl, ok := e.(glider); ok && gl.Gliding() {
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagGliding)
 	}
+	if sl, ok := e.(sleeper); ok {
+		if pos, ok := sl.Sleeping(); ok {
+			m[protocol.EntityDataKeyBedPosition] = protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSleeping)
+			m.SetFlag(protocol.EntityDataKeyPlayerFlags, playerFlagSleep)
+		}
+	}
 	if b, ok := e.(breather); ok {
 		m[protocol.EntityDataKeyAirSupply] = int16(b.AirSupply().Milliseconds() / 50)
 		m[protocol.EntityDataKeyAirSupplyMax] = int16(b.MaxAirSupply().Milliseconds() / 50)
@@ -101,6 +109,12 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 		m[protocol.EntityDataKeyFuseTime] = int32(t.Fuse().Milliseconds() / 50)
 		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagIgnited)
 	}
+	if p, ok := e.(primeable); ok {
+		if fuse, primed := p.Primed(); primed {
+			m[protocol.EntityDataKeyFuseTime] = int32(fuse.Milliseconds() / 50)
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagIgnited)
+		}
+	}
 	if n, ok := e.(named); ok {
 		m[protocol.EntityDataKeyName] = n.NameTag()
 		m[protocol.EntityDataKeyAlwaysShowNameTag] = uint8(1)
@@ -164,6 +178,45 @@ func (s *Session) addSpecificMetadata(e any, m protocol.EntityMetadata) {
 	if mv, ok := e.(markVariable); ok {
 		m[protocol.EntityDataKeyMarkVariant] = mv.MarkVariant()
 	}
+	if b, ok := e.(baby); ok && b.Baby() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagBaby)
+	}
+	if l, ok := e.(lover); ok && l.InLove() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagInLove)
+	}
+	if sa, ok := e.(saddled); ok && sa.Saddled() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSaddled)
+	}
+	if sh, ok := e.(sheared); ok && sh.Sheared() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagSheared)
+	}
+	if c, ok := e.(coloured); ok {
+		m[protocol.EntityDataKeyColorIndex] = c.Colour().Uint8()
+	}
+	if sw, ok := e.(swelling); ok && sw.Swelling() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagIgnited)
+	}
+	if c, ok := e.(charged); ok && c.Charged() {
+		m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagPowered)
+	}
+	if t, ok := e.(trader); ok {
+		m[protocol.EntityDataKeyTradeTier] = int32(t.TradeTier())
+		m[protocol.EntityDataKeyMaxTradeTier] = int32(4)
+		m[protocol.EntityDataKeyTradeExperience] = int32(t.TradeExperience())
+	}
+	if p, ok := e.(paddled); ok {
+		left, right := p.PaddleTime()
+		m[protocol.EntityDataKeyRowTimeLeft] = float32(left)
+		m[protocol.EntityDataKeyRowTimeRight] = float32(right)
+	}
+	if r, ok := e.(world.Rider); ok {
+		if v, ok := r.Vehicle(); ok {
+			m.SetFlag(protocol.EntityDataKeyFlags, protocol.EntityDataFlagRiding)
+			if offset, ok := v.SeatOffset(r); ok {
+				m[protocol.EntityDataKeySeatOffset] = vec64To32(offset)
+			}
+		}
+	}
 }
 
 type sneaker interface {
@@ -182,6 +235,13 @@ type glider interface {
 	Gliding() bool
 }
 
+// playerFlagSleep is the index of the flag in the player flags of an entity that is set while sleeping.
+const playerFlagSleep = 1
+
+type sleeper interface {
+	Sleeping() (cube.Pos, bool)
+}
+
 type breather interface {
 	Breathing() bool
 	AirSupply() time.Duration
@@ -258,6 +318,10 @@ type tnt interface {
 	Fuse() time.Duration
 }
 
+type primeable interface {
+	Primed() (time.Duration, bool)
+}
+
 type living interface {
 	DeathPosition() (mgl64.Vec3, world.Dimension, bool)
 }
@@ -269,3 +333,40 @@ type variable interface {
 type markVariable interface {
 	MarkVariant() int32
 }
+
+type baby interface {
+	Baby() bool
+}
+
+type lover interface {
+	InLove() bool
+}
+
+type saddled interface {
+	Saddled() bool
+}
+
+type sheared interface {
+	Sheared() bool
+}
+
+type coloured interface {
+	Colour() item.Colour
+}
+
+type swelling interface {
+	Swelling() bool
+}
+
+type charged interface {
+	Charged() bool
+}
+
+type paddled interface {
+	PaddleTime() (left, right float64)
+}
+
+type trader interface {
+	TradeTier() int
+	TradeExperience() int
+}
@@ -3,6 +3,7 @@ package session
 import (
 	"fmt"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/gophertunnel-Amethyst-Protocol/minecraft/protocol"
 	"github.com/Adrian8115/gophertunnel-Amethyst-Protocol/minecraft/protocol/packet"
 	"github.com/go-gl/mathgl/mgl32"
@@ -40,6 +41,12 @@ func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Se
 		}
 	}
 
+	if v, ok := s.c.Vehicle(); ok {
+		// The position of a riding player is controlled by the vehicle it rides, so we only handle its input and
+		// rotation.
+		h.handleVehicleInput(pk, v, s)
+		pk.Position = vec64To32(pos.Add(mgl64.Vec3{0, 1.62}))
+	}
 	pk.Position = pk.Position.Sub(mgl32.Vec3{0, 1.62}) // Sub the base offset of players from the pos.
 
 	newPos := vec32To64(pk.Position)
@@ -64,6 +71,25 @@ func (h PlayerAuthInputHandler) handleMovement(pk *packet.PlayerAuthInput, s *Se
 	return nil
 }
 
+// handleVehicleInput passes the movement input in the packet.PlayerAuthInput to the vehicle passed if the
+// Controllable is its driver.
+func (h PlayerAuthInputHandler) handleVehicleInput(pk *packet.PlayerAuthInput, v world.Vehicle, s *Session) {
+	d, ok := v.(world.DrivableVehicle)
+	r, isRider := s.c.(world.Rider)
+	if !ok || !isRider {
+		return
+	}
+	if passengers := v.Passengers(); len(passengers) == 0 || passengers[0] != r {
+		return
+	}
+	d.Drive(r, world.VehicleInput{
+		Forward:  float64(pk.MoveVector[1]),
+		Strafe:   float64(pk.MoveVector[0]),
+		Jumping:  pk.InputData&packet.InputFlagJumping != 0,
+		Rotation: cube.Rotation{float64(pk.Yaw), float64(pk.Pitch)},
+	})
+}
+
 // handleActions handles the actions with the world that are present in the PlayerAuthInput packet.
 func (h PlayerAuthInputHandler) handleActions(pk *packet.PlayerAuthInput, s *Session) error {
 	if pk.InputData&packet.InputFlagPerformItemInteraction != 0 {
@@ -0,0 +1,127 @@
+package session
+
+import (
+	"fmt"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/trade"
+	"github.com/Adrian8115/gophertunnel-Amethyst-Protocol/minecraft/nbt"
+	"github.com/Adrian8115/gophertunnel-Amethyst-Protocol/minecraft/protocol"
+	"math"
+	"math/rand"
+	"strconv"
+)
+
+const (
+	// tradeInputSlot is the slot index of the first input item in the trade window.
+	tradeInputSlot = 0x04
+	// tradeSecondInputSlot is the slot index of the second input item in the trade window.
+	tradeSecondInputSlot = 0x05
+)
+
+// closeTrade closes the trade window currently opened, if any. True is returned if a trade window was opened.
+func (s *Session) closeTrade() bool {
+	w := s.openedTrade.Swap(nil)
+	if w == nil {
+		return false
+	}
+	if w.Close != nil {
+		w.Close(s.c)
+	}
+	return true
+}
+
+// encodeOffers encodes the offers passed to NBT so that they can be sent in an UpdateTrade packet.
+func (s *Session) encodeOffers(offers []trade.Offer) []byte {
+	recipes := make([]any, 0, len(offers))
+	for index, o := range offers {
+		maxUses := int32(o.MaxUses)
+		if maxUses == 0 {
+			maxUses = math.MaxInt32
+		}
+		r := map[string]any{
+			"buyA":             nbtconv.WriteItem(o.Input, true),
+			"buyCountA":        int32(o.Input.Count()),
+			"buyCountB":        int32(o.SecondInput.Count()),
+			"sell":             nbtconv.WriteItem(o.Output, true),
+			"uses":             int32(o.Uses),
+			"maxUses":          maxUses,
+			"tier":             int32(o.Tier),
+			"traderExp":        int32(o.Experience),
+			"rewardExp":        boolByte(o.RewardExperience),
+			"demand":           int32(0),
+			"priceMultiplierA": float32(0),
+			"priceMultiplierB": float32(0),
+			"netId":            int32(s.tradeNetworkID(index)),
+		}
+		if !o.SecondInput.Empty() {
+			r["buyB"] = nbtconv.WriteItem(o.SecondInput, true)
+		}
+		recipes = append(recipes, r)
+	}
+	requirements := make([]any, 0, 5)
+	for tier := 0; tier < 5; tier++ {
+		requirements = append(requirements, map[string]any{strconv.Itoa(tier): int32(trade.TierExperience(tier))})
+	}
+	b, _ := nbt.Marshal(map[string]any{"Recipes": recipes, "TierExpRequirements": requirements})
+	return b
+}
+
+// tradeNetworkID returns the network ID of the offer at the index passed. The IDs of offers follow those of the
+// crafting recipes sent to the session.
+func (s *Session) tradeNetworkID(index int) uint32 {
+	return uint32(len(s.recipes)+index) + 1
+}
+
+// handleTrade handles a CraftRecipe stack request action made using a trade window.
+func (h *ItemStackRequestHandler) handleTrade(a *protocol.CraftRecipeStackRequestAction, s *Session) error {
+	w := s.openedTrade.Load()
+	index := int(a.RecipeNetworkID) - int(s.tradeNetworkID(0))
+	if index < 0 || index >= len(w.Offers) {
+		return fmt.Errorf("trade with network id %v does not exist", a.RecipeNetworkID)
+	}
+	offer := w.Offers[index]
+	if offer.Disabled() || offer.Tier > w.Tier {
+		return fmt.Errorf("trade with network id %v is not available", a.RecipeNetworkID)
+	}
+
+	inputs := []struct {
+		slot     protocol.StackRequestSlotInfo
+		expected item.Stack
+	}{
+		{slot: protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientOne, Slot: tradeInputSlot}, expected: offer.Input},
+		{slot: protocol.StackRequestSlotInfo{ContainerID: protocol.ContainerTradeTwoIngredientTwo, Slot: tradeSecondInputSlot}, expected: offer.SecondInput},
+	}
+	for _, input := range inputs {
+		if input.expected.Empty() {
+			continue
+		}
+		has, _ := h.itemInSlot(input.slot, s)
+		if !has.Comparable(input.expected) || has.Count() < input.expected.Count() {
+			return fmt.Errorf("input item %v does not match expected input %v", has, input.expected)
+		}
+	}
+	for _, input := range inputs {
+		if input.expected.Empty() {
+			continue
+		}
+		has, _ := h.itemInSlot(input.slot, s)
+		h.setItemInSlot(input.slot, has.Grow(-input.expected.Count()), s)
+	}
+
+	w.Offers[index].Uses++
+	if offer.RewardExperience {
+		pos := s.c.Position()
+		if w.Entity != nil {
+			pos = w.Entity.Position()
+		}
+		for _, orb := range entity.NewExperienceOrbs(pos, rand.Intn(4)+3) {
+			s.c.World().AddEntity(orb)
+		}
+	}
+	if w.Trade != nil {
+		w.Trade(s.c, index)
+	}
+	return h.createResults(s, offer.Output)
+}
@@ -51,6 +51,15 @@ func (s *Session) closeCurrentContainer() {
 		return
 	}
 	s.closeWindow()
+	if s.closeTrade() {
+		return
+	}
+	if e := s.openedEntity.Swap(nil); e != nil {
+		if c, ok := e.(entityContainer); ok {
+			c.RemoveViewer(s)
+		}
+		return
+	}
 
 	pos := s.openedPos.Load()
 	w := s.c.World()
@@ -94,6 +103,10 @@ func (s *Session) SendRespawn(pos mgl64.Vec3) {
 // sendRecipes sends the current crafting recipes to the session.
 func (s *Session) sendRecipes() {
 	recipes := make([]protocol.Recipe, 0, len(recipe.Recipes()))
+	var (
+		potionRecipes          []protocol.PotionRecipe
+		containerChangeRecipes []protocol.PotionContainerChangeRecipe
+	)
 	for index, i := range recipe.Recipes() {
 		networkID := uint32(index) + 1
 		s.recipes[networkID] = i
@@ -140,9 +153,46 @@ func (s *Session) sendRecipes() {
 				Block:           i.Block(),
 				RecipeNetworkID: networkID,
 			})
+		case recipe.Potion:
+			input, reagent, output := i.Input()[0].(item.Stack), i.Input()[1], i.Output()[0]
+			inputID, inputMeta, _ := world.ItemRuntimeID(input.Item())
+			reagentID, reagentMeta := recipeItemRuntimeID(reagent)
+			outputID, outputMeta, _ := world.ItemRuntimeID(output.Item())
+			potionRecipes = append(potionRecipes, protocol.PotionRecipe{
+				InputPotionID:        inputID,
+				InputPotionMetadata:  int32(inputMeta),
+				ReagentItemID:        reagentID,
+				ReagentItemMetadata:  int32(reagentMeta),
+				OutputPotionID:       outputID,
+				OutputPotionMetadata: int32(outputMeta),
+			})
+		case recipe.PotionContainerChange:
+			inputID, _, _ := world.ItemRuntimeID(i.Input()[0].(item.Stack).Item())
+			reagentID, _ := recipeItemRuntimeID(i.Input()[1])
+			outputID, _, _ := world.ItemRuntimeID(i.Output()[0].Item())
+			containerChangeRecipes = append(containerChangeRecipes, protocol.PotionContainerChangeRecipe{
+				InputItemID:   inputID,
+				ReagentItemID: reagentID,
+				OutputItemID:  outputID,
+			})
 		}
 	}
-	s.writePacket(&packet.CraftingData{Recipes: recipes, ClearRecipes: true})
+	s.writePacket(&packet.CraftingData{
+		Recipes:                      recipes,
+		PotionRecipes:                potionRecipes,
+		PotionContainerChangeRecipes: containerChangeRecipes,
+		ClearRecipes:                 true,
+	})
+}
+
+// recipeItemRuntimeID returns the runtime ID and metadata of the recipe item passed. Item tags are not supported
+// by brewing recipes sent to the client, so 0 is returned for them.
+func recipeItemRuntimeID(i recipe.Item) (int32, int16) {
+	if s, ok := i.(item.Stack); ok && !s.Empty() {
+		rid, meta, _ := world.ItemRuntimeID(s.Item())
+		return rid, meta
+	}
+	return 0, 0
 }
 
 // sendArmourTrimData sends the armour trim data.
@@ -228,6 +278,9 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 		return s.armour.Inventory(), true
 	case protocol.ContainerLevelEntity:
 		if s.containerOpened.Load() {
+			if s.openedEntity.Load() != nil {
+				return s.openedWindow.Load(), true
+			}
 			b := s.c.World().Block(s.openedPos.Load())
 			if _, chest := b.(block.Chest); chest {
 				return s.openedWindow.Load(), true
@@ -241,6 +294,12 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.openedWindow.Load(), true
 			}
 		}
+	case protocol.ContainerShulkerBox:
+		if s.containerOpened.Load() {
+			if _, shulkerBox := s.c.World().Block(s.openedPos.Load()).(block.ShulkerBox); shulkerBox {
+				return s.openedWindow.Load(), true
+			}
+		}
 	case protocol.ContainerBeaconPayment:
 		if s.containerOpened.Load() {
 			if _, beacon := s.c.World().Block(s.openedPos.Load()).(block.Beacon); beacon {
@@ -283,6 +342,17 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 				return s.ui, true
 			}
 		}
+	case protocol.ContainerTradeIngredientOne, protocol.ContainerTradeIngredientTwo, protocol.ContainerTradeResultPreview,
+		protocol.ContainerTradeTwoIngredientOne, protocol.ContainerTradeTwoIngredientTwo, protocol.ContainerTradeTwoResultPreview:
+		if s.containerOpened.Load() && s.openedTrade.Load() != nil {
+			return s.ui, true
+		}
+	case protocol.ContainerBrewingStandInput, protocol.ContainerBrewingStandResult, protocol.ContainerBrewingStandFuel:
+		if s.containerOpened.Load() {
+			if _, ok := s.c.World().Block(s.openedPos.Load()).(block.BrewingStand); ok {
+				return s.openedWindow.Load(), true
+			}
+		}
 	case protocol.ContainerFurnaceIngredient, protocol.ContainerFurnaceFuel, protocol.ContainerFurnaceResult,
 		protocol.ContainerBlastFurnaceIngredient, protocol.ContainerSmokerIngredient:
 		if s.containerOpened.Load() {
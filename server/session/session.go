@@ -10,6 +10,7 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/recipe"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/trade"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/player/chat"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/player/form"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
@@ -70,6 +71,8 @@ type Session struct {
 	openedContainerID              atomic.Uint32
 	openedWindow                   atomic.Value[*inventory.Inventory]
 	openedPos                      atomic.Value[cube.Pos]
+	openedTrade                    atomic.Value[*trade.Window]
+	openedEntity                   atomic.Value[world.Entity]
 	swingingArm                    atomic.Bool
 
 	recipes map[uint32]recipe.Recipe
@@ -15,6 +15,16 @@ func (s *Session) SendMessage(message string) {
 	})
 }
 
+// SendTranslation ...
+func (s *Session) SendTranslation(key string, a ...string) {
+	s.writePacket(&packet.Text{
+		TextType:         packet.TextTypeTranslation,
+		NeedsTranslation: true,
+		Message:          "%" + key,
+		Parameters:       a,
+	})
+}
+
 // SendTip ...
 func (s *Session) SendTip(message string) {
 	s.writePacket(&packet.Text{
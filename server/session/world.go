@@ -4,6 +4,7 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/entity/effect"
 	"image/color"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/internal/nbtconv"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/inventory"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/item/trade"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/particle"
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world/sound"
@@ -119,6 +121,37 @@ func (s *Session) ViewEntity(e world.Entity) {
 			}}})
 		}
 		return
+	case *entity.NPC:
+		// NPCs are shown as players, which requires them to be in the player list when added. They are removed
+		// from the list right after, so that they don't show up as connected players.
+		s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionAdd, Entries: []protocol.PlayerListEntry{{
+			UUID:           v.UUID(),
+			EntityUniqueID: int64(runtimeID),
+			Username:       v.Name(),
+			Skin:           skinToProtocol(v.Skin()),
+		}}})
+		s.writePacket(&packet.AddPlayer{
+			EntityMetadata:  metadata,
+			EntityRuntimeID: runtimeID,
+			GameType:        packet.GameTypeSurvival,
+			HeadYaw:         float32(yaw),
+			Pitch:           float32(pitch),
+			Position:        vec64To32(e.Position()),
+			UUID:            v.UUID(),
+			Username:        v.Name(),
+			Yaw:             float32(yaw),
+			AbilityData: protocol.AbilityData{
+				EntityUniqueID: int64(runtimeID),
+				Layers: []protocol.AbilityLayer{{
+					Type:      protocol.AbilityLayerTypeBase,
+					Abilities: protocol.AbilityCount - 1,
+				}},
+			},
+		})
+		s.writePacket(&packet.PlayerList{ActionType: packet.PlayerListActionRemove, Entries: []protocol.PlayerListEntry{{
+			UUID: v.UUID(),
+		}}})
+		return
 	case *entity.Ent:
 		switch e.Type().(type) {
 		case entity.ItemType:
@@ -531,6 +564,45 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 			EventType: packet.LevelEventWaxOn,
 			Position:  vec64To32(pos),
 		})
+		pk.SoundType = packet.SoundEventCopperWaxOn
+	case sound.WaxRemoved:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventWaxOff,
+			Position:  vec64To32(pos),
+		})
+		pk.SoundType = packet.SoundEventCopperWaxOff
+	case sound.CopperScraped:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventScrape,
+			Position:  vec64To32(pos),
+		})
+		pk.SoundType = packet.SoundEventScrape
+	case sound.AmethystChime:
+		pk.SoundType = packet.SoundEventAmethystBlockChime
+	case sound.BeehiveEnter:
+		pk.SoundType = packet.SoundEventBeehiveEnter
+	case sound.BeehiveExit:
+		pk.SoundType = packet.SoundEventBeehiveExit
+	case sound.BeehiveShear:
+		pk.SoundType = packet.SoundEventBeehiveShear
+	case sound.SculkSensorPowerOn:
+		pk.SoundType = packet.SoundEventSculkSensorPowerOn
+	case sound.SculkSensorPowerOff:
+		pk.SoundType = packet.SoundEventSculkSensorPowerOff
+	case sound.SculkCatalystBloom:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventSculkCatalystBloom,
+			Position:  vec64To32(pos),
+		})
+		pk.SoundType = packet.SoundEventSculkCatalystBloom
+	case sound.SculkShriek:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventParticleSculkShriek,
+			Position:  vec64To32(pos),
+		})
+		pk.SoundType = packet.SoundEventSculkShriekerShriek
+	case sound.SculkSpread:
+		pk.SoundType = packet.SoundEventSculkSpread
 	case sound.WaxedSignFailedInteraction:
 		pk.SoundType = packet.SoundEventWaxedSignInteractFail
 	case sound.Pop:
@@ -589,6 +661,61 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventFurnaceUse
 	case sound.BlastFurnaceCrackle:
 		pk.SoundType = packet.SoundEventBlastFurnaceUse
+	case sound.PotionBrewed:
+		pk.SoundType = packet.SoundEventPotionBrewed
+	case sound.CauldronFillWater:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronFillWater,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronTakeWater:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronTakeWater,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronFillPotion:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronFillPotion,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronTakePotion:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronTakePotion,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronAddDye:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronAddDye,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronDyeArmour:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronDyeArmor,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronCleanArmour:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronCleanArmor,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronExplode:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.LevelEventCauldronExplode,
+			Position:  vec64To32(pos),
+		})
+		return
+	case sound.CauldronDrip:
+		pk.SoundType = packet.SoundEventPointedDripstoneCauldronDripWater
+		if so.Lava {
+			pk.SoundType = packet.SoundEventPointedDripstoneCauldronDripLava
+		}
 	case sound.SmokerCrackle:
 		pk.SoundType = packet.SoundEventSmokerUse
 	case sound.UseSpyglass:
@@ -616,8 +743,24 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		}
 	case sound.FireExtinguish:
 		pk.SoundType = packet.SoundEventExtinguishFire
+	case sound.CampfireCrackle:
+		pk.SoundType = packet.SoundEventCampfireCrackle
 	case sound.Ignite:
 		pk.SoundType = packet.SoundEventIgnite
+	case sound.PowerOn:
+		pk.SoundType = packet.SoundEventPowerOn
+	case sound.PowerOff:
+		pk.SoundType = packet.SoundEventPowerOff
+	case sound.RespawnAnchorCharge:
+		pk.SoundType = packet.SoundEventRespawnAnchorCharge
+	case sound.RespawnAnchorDeplete:
+		pk.SoundType = packet.SoundEventRespawnAnchorDeplete
+	case sound.RespawnAnchorSetSpawn:
+		pk.SoundType = packet.SoundEventRespawnAnchorSetSpawn
+	case sound.ShulkerBoxOpen:
+		pk.SoundType = packet.SoundEventShulkerBoxOpen
+	case sound.ShulkerBoxClose:
+		pk.SoundType = packet.SoundEventShulkerBoxClosed
 	case sound.Burning:
 		pk.SoundType = packet.SoundEventPlayerHurtOnFire
 	case sound.Drowning:
@@ -631,6 +774,12 @@ func (s *Session) playSound(pos mgl64.Vec3, t world.Sound, disableRelative bool)
 		pk.SoundType = packet.SoundEventFallSmall
 	case sound.Burp:
 		pk.SoundType = packet.SoundEventBurp
+	case sound.Milk:
+		pk.SoundType = packet.SoundEventMilk
+	case sound.Shear:
+		pk.SoundType = packet.SoundEventShear
+	case sound.Saddle:
+		pk.SoundType = packet.SoundEventSaddle
 	case sound.DoorOpen:
 		pk.SoundType, pk.ExtraData = packet.SoundEventDoorOpen, int32(world.BlockRuntimeID(so.Block))
 	case sound.DoorClose:
@@ -830,6 +979,33 @@ func (s *Session) ViewFurnaceUpdate(prevCookTime, cookTime, prevRemainingFuelTim
 	}
 }
 
+// ViewBrewingUpdate updates a brewing stand for the associated session based on previous times and fuel.
+func (s *Session) ViewBrewingUpdate(prevBrewTime, brewTime time.Duration, prevFuelAmount, fuelAmount, prevFuelTotal, fuelTotal int32) {
+	if prevBrewTime != brewTime {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandBrewTime,
+			Value:    int32(brewTime.Milliseconds() / 50),
+		})
+	}
+
+	if prevFuelAmount != fuelAmount {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandFuelAmount,
+			Value:    fuelAmount,
+		})
+	}
+
+	if prevFuelTotal != fuelTotal {
+		s.writePacket(&packet.ContainerSetData{
+			WindowID: byte(s.openedWindowID.Load()),
+			Key:      packet.ContainerDataBrewingStandFuelTotal,
+			Value:    fuelTotal,
+		})
+	}
+}
+
 // ViewBlockUpdate ...
 func (s *Session) ViewBlockUpdate(pos cube.Pos, b world.Block, layer int) {
 	blockPos := protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
@@ -872,6 +1048,11 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 			EntityRuntimeID: s.entityRuntimeID(e),
 			EventType:       packet.ActorEventHurt,
 		})
+	case entity.WakeUpAction:
+		s.writePacket(&packet.Animate{
+			ActionType:      packet.AnimateActionStopSleep,
+			EntityRuntimeID: s.entityRuntimeID(e),
+		})
 	case entity.CriticalHitAction:
 		s.writePacket(&packet.Animate{
 			ActionType:      packet.AnimateActionCriticalHit,
@@ -924,6 +1105,11 @@ func (s *Session) ViewEntityAction(e world.Entity, a world.EntityAction) {
 			EntityRuntimeID: s.entityRuntimeID(e),
 			EventType:       packet.ActorEventTalismanActivate,
 		})
+	case entity.EatGrassAction:
+		s.writePacket(&packet.ActorEvent{
+			EntityRuntimeID: s.entityRuntimeID(e),
+			EventType:       packet.ActorEventEatGrass,
+		})
 	}
 }
 
@@ -945,6 +1131,39 @@ func (s *Session) ViewEntityAnimation(e world.Entity, animationName string) {
 	})
 }
 
+// ViewEntityMount ...
+func (s *Session) ViewEntityMount(e, vehicle world.Entity, driver bool) {
+	linkType := byte(protocol.EntityLinkPassenger)
+	if driver {
+		linkType = protocol.EntityLinkRider
+	}
+	s.viewEntityLink(e, vehicle, linkType)
+}
+
+// ViewEntityDismount ...
+func (s *Session) ViewEntityDismount(e, vehicle world.Entity) {
+	s.viewEntityLink(e, vehicle, protocol.EntityLinkRemove)
+}
+
+// viewEntityLink sends a link of the type passed between a rider and the vehicle it rides. Nothing is sent if
+// either of the entities is not visible to the Session.
+func (s *Session) viewEntityLink(e, vehicle world.Entity, linkType byte) {
+	if s.entityHidden(e) || s.entityHidden(vehicle) {
+		return
+	}
+	riderID, vehicleID := s.entityRuntimeID(e), s.entityRuntimeID(vehicle)
+	if riderID == 0 || vehicleID == 0 {
+		// One of the entities isn't shown to the session yet. The link is sent once both are shown.
+		return
+	}
+	s.writePacket(&packet.SetActorLink{EntityLink: protocol.EntityLink{
+		RiddenEntityUniqueID: int64(vehicleID),
+		RiderEntityUniqueID:  int64(riderID),
+		Type:                 linkType,
+		RiderInitiated:       true,
+	}})
+}
+
 // OpenBlockContainer ...
 func (s *Session) OpenBlockContainer(pos cube.Pos) {
 	if s.containerOpened.Load() && s.openedPos.Load() == pos {
@@ -1000,6 +1219,59 @@ func (s *Session) OpenBlockContainer(pos cube.Pos) {
 	})
 }
 
+// entityContainer is an entity with an inventory that may be viewed by multiple viewers at once, such as a boat
+// with a chest.
+type entityContainer interface {
+	AddViewer(v entity.ContainerViewer)
+	RemoveViewer(v entity.ContainerViewer)
+}
+
+// CloseEntityContainer closes the inventory of the entity passed if it is currently opened.
+func (s *Session) CloseEntityContainer(e world.Entity) {
+	if s.openedEntity.Load() == e {
+		s.closeCurrentContainer()
+	}
+}
+
+// OpenEntityContainer opens the inventory of an entity, such as a boat with a chest or a minecart with a hopper.
+// Any container that was previously opened is closed.
+func (s *Session) OpenEntityContainer(e world.Entity) {
+	var (
+		inv           *inventory.Inventory
+		containerType byte
+	)
+	switch e := e.(type) {
+	case *entity.Boat:
+		inv, containerType = e.Inventory(), protocol.ContainerTypeChestBoat
+	case *entity.Minecart:
+		inv, containerType = e.Inventory(), protocol.ContainerTypeCartChest
+		if _, ok := e.Type().(entity.HopperMinecartType); ok {
+			containerType = protocol.ContainerTypeCartHopper
+		}
+	}
+	if inv == nil {
+		return
+	}
+	s.closeCurrentContainer()
+	if c, ok := e.(entityContainer); ok {
+		c.AddViewer(s)
+	}
+
+	nextID := s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(inv)
+	s.openedPos.Store(cube.PosFromVec3(e.Position()))
+	s.openedEntity.Store(e)
+
+	s.openedContainerID.Store(uint32(containerType))
+	s.writePacket(&packet.ContainerOpen{
+		WindowID:                nextID,
+		ContainerType:           containerType,
+		ContainerEntityUniqueID: int64(s.entityRuntimeID(e)),
+	})
+	s.sendInv(inv, uint32(nextID))
+}
+
 // openNormalContainer opens a normal container that can hold items in it server-side.
 func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 	b.AddViewer(s, s.c.World(), pos)
@@ -1017,6 +1289,8 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 		containerType = protocol.ContainerTypeBlastFurnace
 	case block.Smoker:
 		containerType = protocol.ContainerTypeSmoker
+	case block.BrewingStand:
+		containerType = protocol.ContainerTypeBrewingStand
 	}
 
 	s.writePacket(&packet.ContainerOpen{
@@ -1028,6 +1302,65 @@ func (s *Session) openNormalContainer(b block.Container, pos cube.Pos) {
 	s.sendInv(b.Inventory(), uint32(nextID))
 }
 
+// OpenTrade opens the trade window passed for the session. Any container that was previously opened is closed.
+func (s *Session) OpenTrade(w trade.Window) {
+	if s == Nop {
+		return
+	}
+	s.closeCurrentContainer()
+
+	w.Offers = slices.Clone(w.Offers)
+	e := w.Entity
+	if e == nil {
+		e = s.c
+	}
+
+	nextID := s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(inventory.New(1, nil))
+	s.openedPos.Store(cube.PosFromVec3(e.Position()))
+	s.openedTrade.Store(&w)
+	s.sendTrade(nextID, w)
+}
+
+// UpdateTrade updates the tier and offers of the trade window currently opened by the session and sends them to
+// the client. Nothing happens if no trade window is opened.
+func (s *Session) UpdateTrade(tier int, offers []trade.Offer) {
+	if s == Nop {
+		return
+	}
+	w := s.openedTrade.Load()
+	if w == nil {
+		return
+	}
+	updated := *w
+	updated.Tier, updated.Offers = tier, slices.Clone(offers)
+	if !s.openedTrade.CompareAndSwap(w, &updated) {
+		// The trade window was closed or replaced in the meantime.
+		return
+	}
+	s.sendTrade(byte(s.openedWindowID.Load()), updated)
+}
+
+// sendTrade sends the trade window passed to the client using the window ID passed.
+func (s *Session) sendTrade(windowID byte, w trade.Window) {
+	villagerID := int64(selfEntityRuntimeID)
+	if w.Entity != nil && w.Entity != s.c {
+		villagerID = int64(s.entityRuntimeID(w.Entity))
+	}
+	s.writePacket(&packet.UpdateTrade{
+		WindowID:         windowID,
+		WindowType:       protocol.ContainerTypeTrade,
+		Size:             int32(len(w.Offers)),
+		TradeTier:        int32(w.Tier),
+		VillagerUniqueID: villagerID,
+		EntityUniqueID:   selfEntityRuntimeID,
+		DisplayName:      w.Name,
+		NewTradeUI:       true,
+		SerialisedOffers: s.encodeOffers(w.Offers),
+	})
+}
+
 // ViewSlotChange ...
 func (s *Session) ViewSlotChange(slot int, newItem item.Stack) {
 	if !s.containerOpened.Load() {
@@ -1097,6 +1430,11 @@ func (s *Session) ViewSkin(e world.Entity) {
 			UUID: v.UUID(),
 			Skin: skinToProtocol(v.Skin()),
 		})
+	case *entity.NPC:
+		s.writePacket(&packet.PlayerSkin{
+			UUID: v.UUID(),
+			Skin: skinToProtocol(v.Skin()),
+		})
 	}
 }
 
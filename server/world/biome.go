@@ -13,6 +13,14 @@ type Biome interface {
 	EncodeBiome() int
 }
 
+// SpawningBiome is a Biome in which entities may spawn naturally. Biomes that do not implement SpawningBiome
+// never have entities spawn in them naturally.
+type SpawningBiome interface {
+	Biome
+	// Spawns returns the spawn list of the biome: The entities that may spawn naturally in the biome.
+	Spawns() []SpawnEntry
+}
+
 // biomes holds a map of id => Biome to be used for looking up the biome by an ID. It is registered
 // to when calling RegisterBiome.
 var biomes = map[int]Biome{}
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Badlands ...
 type Badlands struct{}
 
@@ -18,6 +20,11 @@ func (Badlands) String() string {
 	return "mesa"
 }
 
+// Spawns ...
+func (Badlands) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (Badlands) EncodeBiome() int {
 	return 37
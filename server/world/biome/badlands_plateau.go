@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // BadlandsPlateau ...
 type BadlandsPlateau struct{}
 
@@ -18,6 +20,11 @@ func (BadlandsPlateau) String() string {
 	return "mesa_plateau"
 }
 
+// Spawns ...
+func (BadlandsPlateau) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (BadlandsPlateau) EncodeBiome() int {
 	return 39
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // BambooJungle ...
 type BambooJungle struct{}
 
@@ -18,6 +20,11 @@ func (BambooJungle) String() string {
 	return "bamboo_jungle"
 }
 
+// Spawns ...
+func (BambooJungle) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (BambooJungle) EncodeBiome() int {
 	return 48
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // BambooJungleHills ...
 type BambooJungleHills struct{}
 
@@ -18,6 +20,11 @@ func (BambooJungleHills) String() string {
 	return "bamboo_jungle_hills"
 }
 
+// Spawns ...
+func (BambooJungleHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (BambooJungleHills) EncodeBiome() int {
 	return 49
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Beach ...
 type Beach struct{}
 
@@ -18,6 +20,11 @@ func (Beach) String() string {
 	return "beach"
 }
 
+// Spawns ...
+func (Beach) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (Beach) EncodeBiome() int {
 	return 16
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // BirchForest ...
 type BirchForest struct{}
 
@@ -18,6 +20,11 @@ func (BirchForest) String() string {
 	return "birch_forest"
 }
 
+// Spawns ...
+func (BirchForest) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (BirchForest) EncodeBiome() int {
 	return 27
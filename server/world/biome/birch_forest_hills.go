@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // BirchForestHills ...
 type BirchForestHills struct{}
 
@@ -18,6 +20,11 @@ func (BirchForestHills) String() string {
 	return "birch_forest_hills"
 }
 
+// Spawns ...
+func (BirchForestHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (BirchForestHills) EncodeBiome() int {
 	return 28
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // CherryGrove ...
 type CherryGrove struct{}
 
@@ -18,6 +20,11 @@ func (CherryGrove) String() string {
 	return "cherry_grove"
 }
 
+// Spawns ...
+func (CherryGrove) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (CherryGrove) EncodeBiome() int {
 	return 192
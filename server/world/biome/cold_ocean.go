@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ColdOcean ...
 type ColdOcean struct{}
 
@@ -18,6 +20,11 @@ func (ColdOcean) String() string {
 	return "cold_ocean"
 }
 
+// Spawns ...
+func (ColdOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (ColdOcean) EncodeBiome() int {
 	return 44
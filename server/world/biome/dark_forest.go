@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DarkForest ...
 type DarkForest struct{}
 
@@ -18,6 +20,11 @@ func (DarkForest) String() string {
 	return "roofed_forest"
 }
 
+// Spawns ...
+func (DarkForest) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (DarkForest) EncodeBiome() int {
 	return 29
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DarkForestHills ...
 type DarkForestHills struct{}
 
@@ -18,6 +20,11 @@ func (DarkForestHills) String() string {
 	return "roofed_forest_mutated"
 }
 
+// Spawns ...
+func (DarkForestHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (DarkForestHills) EncodeBiome() int {
 	return 157
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DeepColdOcean ...
 type DeepColdOcean struct{}
 
@@ -18,6 +20,11 @@ func (DeepColdOcean) String() string {
 	return "deep_cold_ocean"
 }
 
+// Spawns ...
+func (DeepColdOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DeepColdOcean) EncodeBiome() int {
 	return 45
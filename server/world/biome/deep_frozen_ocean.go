@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DeepFrozenOcean ...
 type DeepFrozenOcean struct{}
 
@@ -18,6 +20,11 @@ func (DeepFrozenOcean) String() string {
 	return "deep_frozen_ocean"
 }
 
+// Spawns ...
+func (DeepFrozenOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DeepFrozenOcean) EncodeBiome() int {
 	return 47
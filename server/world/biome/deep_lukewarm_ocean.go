@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DeepLukewarmOcean ...
 type DeepLukewarmOcean struct{}
 
@@ -18,6 +20,11 @@ func (DeepLukewarmOcean) String() string {
 	return "deep_lukewarm_ocean"
 }
 
+// Spawns ...
+func (DeepLukewarmOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DeepLukewarmOcean) EncodeBiome() int {
 	return 43
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DeepOcean ...
 type DeepOcean struct{}
 
@@ -18,6 +20,11 @@ func (DeepOcean) String() string {
 	return "deep_ocean"
 }
 
+// Spawns ...
+func (DeepOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DeepOcean) EncodeBiome() int {
 	return 24
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DeepWarmOcean ...
 type DeepWarmOcean struct{}
 
@@ -18,6 +20,11 @@ func (DeepWarmOcean) String() string {
 	return "deep_warm_ocean"
 }
 
+// Spawns ...
+func (DeepWarmOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DeepWarmOcean) EncodeBiome() int {
 	return 41
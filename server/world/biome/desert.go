@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Desert ...
 type Desert struct{}
 
@@ -18,6 +20,11 @@ func (Desert) String() string {
 	return "desert"
 }
 
+// Spawns ...
+func (Desert) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (Desert) EncodeBiome() int {
 	return 2
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DesertHills ...
 type DesertHills struct{}
 
@@ -18,6 +20,11 @@ func (DesertHills) String() string {
 	return "desert_hills"
 }
 
+// Spawns ...
+func (DesertHills) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DesertHills) EncodeBiome() int {
 	return 17
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DesertLakes ...
 type DesertLakes struct{}
 
@@ -18,6 +20,11 @@ func (DesertLakes) String() string {
 	return "desert_mutated"
 }
 
+// Spawns ...
+func (DesertLakes) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DesertLakes) EncodeBiome() int {
 	return 130
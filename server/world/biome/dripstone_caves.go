@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // DripstoneCaves ...
 type DripstoneCaves struct{}
 
@@ -18,6 +20,11 @@ func (DripstoneCaves) String() string {
 	return "dripstone_caves"
 }
 
+// Spawns ...
+func (DripstoneCaves) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (DripstoneCaves) EncodeBiome() int {
 	return 188
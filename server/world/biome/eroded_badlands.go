@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ErodedBadlands ...
 type ErodedBadlands struct{}
 
@@ -18,6 +20,11 @@ func (ErodedBadlands) String() string {
 	return "mesa_bryce"
 }
 
+// Spawns ...
+func (ErodedBadlands) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (ErodedBadlands) EncodeBiome() int {
 	return 165
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // FlowerForest ...
 type FlowerForest struct{}
 
@@ -18,6 +20,11 @@ func (FlowerForest) String() string {
 	return "flower_forest"
 }
 
+// Spawns ...
+func (FlowerForest) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (FlowerForest) EncodeBiome() int {
 	return 132
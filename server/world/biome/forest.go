@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Forest ...
 type Forest struct{}
 
@@ -18,6 +20,11 @@ func (Forest) String() string {
 	return "forest"
 }
 
+// Spawns ...
+func (Forest) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Forest) EncodeBiome() int {
 	return 4
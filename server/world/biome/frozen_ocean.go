@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // FrozenOcean ...
 type FrozenOcean struct{}
 
@@ -18,6 +20,11 @@ func (FrozenOcean) String() string {
 	return "frozen_ocean"
 }
 
+// Spawns ...
+func (FrozenOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (FrozenOcean) EncodeBiome() int {
 	return 46
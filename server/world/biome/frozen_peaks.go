@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // FrozenPeaks ...
 type FrozenPeaks struct{}
 
@@ -18,6 +20,11 @@ func (FrozenPeaks) String() string {
 	return "frozen_peaks"
 }
 
+// Spawns ...
+func (FrozenPeaks) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (FrozenPeaks) EncodeBiome() int {
 	return 183
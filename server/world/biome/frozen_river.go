@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // FrozenRiver ...
 type FrozenRiver struct{}
 
@@ -18,6 +20,11 @@ func (FrozenRiver) String() string {
 	return "frozen_river"
 }
 
+// Spawns ...
+func (FrozenRiver) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (FrozenRiver) EncodeBiome() int {
 	return 11
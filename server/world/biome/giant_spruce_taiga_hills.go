@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // GiantSpruceTaigaHills ...
 type GiantSpruceTaigaHills struct{}
 
@@ -18,6 +20,11 @@ func (GiantSpruceTaigaHills) String() string {
 	return "redwood_taiga_hills_mutated"
 }
 
+// Spawns ...
+func (GiantSpruceTaigaHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (GiantSpruceTaigaHills) EncodeBiome() int {
 	return 161
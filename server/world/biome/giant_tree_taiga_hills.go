@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // GiantTreeTaigaHills ...
 type GiantTreeTaigaHills struct{}
 
@@ -18,6 +20,11 @@ func (GiantTreeTaigaHills) String() string {
 	return "mega_taiga_hills"
 }
 
+// Spawns ...
+func (GiantTreeTaigaHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (GiantTreeTaigaHills) EncodeBiome() int {
 	return 33
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // GravellyMountainsPlus ...
 type GravellyMountainsPlus struct{}
 
@@ -18,6 +20,11 @@ func (GravellyMountainsPlus) String() string {
 	return "extreme_hills_plus_trees_mutated"
 }
 
+// Spawns ...
+func (GravellyMountainsPlus) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (GravellyMountainsPlus) EncodeBiome() int {
 	return 162
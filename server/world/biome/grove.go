@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Grove ...
 type Grove struct{}
 
@@ -18,6 +20,11 @@ func (Grove) String() string {
 	return "grove"
 }
 
+// Spawns ...
+func (Grove) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (Grove) EncodeBiome() int {
 	return 185
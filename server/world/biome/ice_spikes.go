@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // IceSpikes ...
 type IceSpikes struct{}
 
@@ -18,6 +20,11 @@ func (IceSpikes) String() string {
 	return "ice_plains_spikes"
 }
 
+// Spawns ...
+func (IceSpikes) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (IceSpikes) EncodeBiome() int {
 	return 140
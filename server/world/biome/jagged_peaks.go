@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // JaggedPeaks ...
 type JaggedPeaks struct{}
 
@@ -18,6 +20,11 @@ func (JaggedPeaks) String() string {
 	return "jagged_peaks"
 }
 
+// Spawns ...
+func (JaggedPeaks) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (JaggedPeaks) EncodeBiome() int {
 	return 182
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Jungle ...
 type Jungle struct{}
 
@@ -18,6 +20,11 @@ func (Jungle) String() string {
 	return "jungle"
 }
 
+// Spawns ...
+func (Jungle) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Jungle) EncodeBiome() int {
 	return 21
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // JungleEdge ...
 type JungleEdge struct{}
 
@@ -18,6 +20,11 @@ func (JungleEdge) String() string {
 	return "jungle_edge"
 }
 
+// Spawns ...
+func (JungleEdge) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (JungleEdge) EncodeBiome() int {
 	return 23
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // JungleHills ...
 type JungleHills struct{}
 
@@ -18,6 +20,11 @@ func (JungleHills) String() string {
 	return "jungle_hills"
 }
 
+// Spawns ...
+func (JungleHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (JungleHills) EncodeBiome() int {
 	return 22
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // LegacyFrozenOcean ...
 type LegacyFrozenOcean struct{}
 
@@ -18,6 +20,11 @@ func (LegacyFrozenOcean) String() string {
 	return "legacy_frozen_ocean"
 }
 
+// Spawns ...
+func (LegacyFrozenOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (LegacyFrozenOcean) EncodeBiome() int {
 	return 10
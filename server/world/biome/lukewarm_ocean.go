@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // LukewarmOcean ...
 type LukewarmOcean struct{}
 
@@ -18,6 +20,11 @@ func (LukewarmOcean) String() string {
 	return "lukewarm_ocean"
 }
 
+// Spawns ...
+func (LukewarmOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (LukewarmOcean) EncodeBiome() int {
 	return 42
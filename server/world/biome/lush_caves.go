@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // LushCaves ...
 type LushCaves struct{}
 
@@ -18,6 +20,11 @@ func (LushCaves) String() string {
 	return "lush_caves"
 }
 
+// Spawns ...
+func (LushCaves) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (LushCaves) EncodeBiome() int {
 	return 187
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // MangroveSwamp ...
 type MangroveSwamp struct{}
 
@@ -18,6 +20,11 @@ func (MangroveSwamp) String() string {
 	return "mangrove_swamp"
 }
 
+// Spawns ...
+func (MangroveSwamp) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (MangroveSwamp) EncodeBiome() int {
 	return 191
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Meadow ...
 type Meadow struct{}
 
@@ -18,6 +20,11 @@ func (Meadow) String() string {
 	return "meadow"
 }
 
+// Spawns ...
+func (Meadow) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Meadow) EncodeBiome() int {
 	return 186
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ModifiedBadlandsPlateau ...
 type ModifiedBadlandsPlateau struct{}
 
@@ -18,6 +20,11 @@ func (ModifiedBadlandsPlateau) String() string {
 	return "mesa_plateau_mutated"
 }
 
+// Spawns ...
+func (ModifiedBadlandsPlateau) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (ModifiedBadlandsPlateau) EncodeBiome() int {
 	return 167
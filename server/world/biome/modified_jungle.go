@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ModifiedJungle ...
 type ModifiedJungle struct{}
 
@@ -18,6 +20,11 @@ func (ModifiedJungle) String() string {
 	return "jungle_mutated"
 }
 
+// Spawns ...
+func (ModifiedJungle) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (ModifiedJungle) EncodeBiome() int {
 	return 149
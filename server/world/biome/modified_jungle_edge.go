@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ModifiedJungleEdge ...
 type ModifiedJungleEdge struct{}
 
@@ -18,6 +20,11 @@ func (ModifiedJungleEdge) String() string {
 	return "jungle_edge_mutated"
 }
 
+// Spawns ...
+func (ModifiedJungleEdge) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (ModifiedJungleEdge) EncodeBiome() int {
 	return 151
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ModifiedWoodedBadlandsPlateau ...
 type ModifiedWoodedBadlandsPlateau struct{}
 
@@ -18,6 +20,11 @@ func (ModifiedWoodedBadlandsPlateau) String() string {
 	return "mesa_plateau_stone_mutated"
 }
 
+// Spawns ...
+func (ModifiedWoodedBadlandsPlateau) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (ModifiedWoodedBadlandsPlateau) EncodeBiome() int {
 	return 166
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // MountainEdge ...
 type MountainEdge struct{}
 
@@ -18,6 +20,11 @@ func (MountainEdge) String() string {
 	return "extreme_hills_edge"
 }
 
+// Spawns ...
+func (MountainEdge) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (MountainEdge) EncodeBiome() int {
 	return 20
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Ocean ...
 type Ocean struct{}
 
@@ -18,6 +20,11 @@ func (Ocean) String() string {
 	return "ocean"
 }
 
+// Spawns ...
+func (Ocean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (Ocean) EncodeBiome() int {
 	return 0
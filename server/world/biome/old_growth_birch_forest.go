@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // OldGrowthBirchForest ...
 type OldGrowthBirchForest struct{}
 
@@ -18,6 +20,11 @@ func (OldGrowthBirchForest) String() string {
 	return "birch_forest_mutated"
 }
 
+// Spawns ...
+func (OldGrowthBirchForest) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (OldGrowthBirchForest) EncodeBiome() int {
 	return 155
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // OldGrowthPineTaiga ...
 type OldGrowthPineTaiga struct{}
 
@@ -18,6 +20,11 @@ func (OldGrowthPineTaiga) String() string {
 	return "mega_taiga"
 }
 
+// Spawns ...
+func (OldGrowthPineTaiga) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (OldGrowthPineTaiga) EncodeBiome() int {
 	return 32
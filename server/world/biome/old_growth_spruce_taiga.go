@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // OldGrowthSpruceTaiga ...
 type OldGrowthSpruceTaiga struct{}
 
@@ -18,6 +20,11 @@ func (OldGrowthSpruceTaiga) String() string {
 	return "redwood_taiga_mutated"
 }
 
+// Spawns ...
+func (OldGrowthSpruceTaiga) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (OldGrowthSpruceTaiga) EncodeBiome() int {
 	return 160
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Plains ...
 type Plains struct{}
 
@@ -18,6 +20,11 @@ func (Plains) String() string {
 	return "plains"
 }
 
+// Spawns ...
+func (Plains) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Plains) EncodeBiome() int {
 	return 1
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // River ...
 type River struct{}
 
@@ -18,6 +20,11 @@ func (River) String() string {
 	return "river"
 }
 
+// Spawns ...
+func (River) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (River) EncodeBiome() int {
 	return 7
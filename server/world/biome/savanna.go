@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Savanna ...
 type Savanna struct{}
 
@@ -18,6 +20,11 @@ func (Savanna) String() string {
 	return "savanna"
 }
 
+// Spawns ...
+func (Savanna) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Savanna) EncodeBiome() int {
 	return 35
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SavannaPlateau ...
 type SavannaPlateau struct{}
 
@@ -18,6 +20,11 @@ func (SavannaPlateau) String() string {
 	return "savanna_plateau"
 }
 
+// Spawns ...
+func (SavannaPlateau) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (SavannaPlateau) EncodeBiome() int {
 	return 36
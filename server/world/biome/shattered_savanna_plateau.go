@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // ShatteredSavannaPlateau ...
 type ShatteredSavannaPlateau struct{}
 
@@ -18,6 +20,11 @@ func (ShatteredSavannaPlateau) String() string {
 	return "savanna_plateau_mutated"
 }
 
+// Spawns ...
+func (ShatteredSavannaPlateau) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (ShatteredSavannaPlateau) EncodeBiome() int {
 	return 164
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowyBeach ...
 type SnowyBeach struct{}
 
@@ -18,6 +20,11 @@ func (SnowyBeach) String() string {
 	return "cold_beach"
 }
 
+// Spawns ...
+func (SnowyBeach) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (SnowyBeach) EncodeBiome() int {
 	return 26
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowyMountains ...
 type SnowyMountains struct{}
 
@@ -18,6 +20,11 @@ func (SnowyMountains) String() string {
 	return "ice_mountains"
 }
 
+// Spawns ...
+func (SnowyMountains) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (SnowyMountains) EncodeBiome() int {
 	return 13
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowyPlains ...
 type SnowyPlains struct{}
 
@@ -18,6 +20,11 @@ func (SnowyPlains) String() string {
 	return "ice_plains"
 }
 
+// Spawns ...
+func (SnowyPlains) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (SnowyPlains) EncodeBiome() int {
 	return 12
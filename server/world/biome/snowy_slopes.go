@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowySlopes ...
 type SnowySlopes struct{}
 
@@ -18,6 +20,11 @@ func (SnowySlopes) String() string {
 	return "snowy_slopes"
 }
 
+// Spawns ...
+func (SnowySlopes) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (SnowySlopes) EncodeBiome() int {
 	return 184
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowyTaiga ...
 type SnowyTaiga struct{}
 
@@ -18,6 +20,11 @@ func (SnowyTaiga) String() string {
 	return "cold_taiga"
 }
 
+// Spawns ...
+func (SnowyTaiga) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (SnowyTaiga) EncodeBiome() int {
 	return 30
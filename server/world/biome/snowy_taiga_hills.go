@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowyTaigaHills ...
 type SnowyTaigaHills struct{}
 
@@ -18,6 +20,11 @@ func (SnowyTaigaHills) String() string {
 	return "cold_taiga_hills"
 }
 
+// Spawns ...
+func (SnowyTaigaHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (SnowyTaigaHills) EncodeBiome() int {
 	return 31
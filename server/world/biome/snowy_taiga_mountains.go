@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SnowyTaigaMountains ...
 type SnowyTaigaMountains struct{}
 
@@ -18,6 +20,11 @@ func (SnowyTaigaMountains) String() string {
 	return "cold_taiga_mutated"
 }
 
+// Spawns ...
+func (SnowyTaigaMountains) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (SnowyTaigaMountains) EncodeBiome() int {
 	return 158
@@ -0,0 +1,20 @@
+package biome
+
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
+var (
+	// monsterSpawns holds the monsters that spawn in most overworld biomes.
+	monsterSpawns = []world.SpawnEntry{
+		{Entity: "minecraft:creeper", Weight: 100, MinGroup: 1, MaxGroup: 1},
+		{Entity: "minecraft:skeleton", Weight: 100, MinGroup: 4, MaxGroup: 4},
+		{Entity: "minecraft:spider", Weight: 100, MinGroup: 4, MaxGroup: 4},
+		{Entity: "minecraft:zombie", Weight: 100, MinGroup: 4, MaxGroup: 4},
+	}
+	// overworldSpawns holds the monsters and farm animals that spawn in most grassy overworld biomes.
+	overworldSpawns = append([]world.SpawnEntry{
+		{Entity: "minecraft:chicken", Weight: 10, MinGroup: 4, MaxGroup: 4},
+		{Entity: "minecraft:cow", Weight: 8, MinGroup: 4, MaxGroup: 4},
+		{Entity: "minecraft:pig", Weight: 10, MinGroup: 4, MaxGroup: 4},
+		{Entity: "minecraft:sheep", Weight: 12, MinGroup: 4, MaxGroup: 4},
+	}, monsterSpawns...)
+)
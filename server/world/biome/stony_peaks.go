@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // StonyPeaks ...
 type StonyPeaks struct{}
 
@@ -18,6 +20,11 @@ func (StonyPeaks) String() string {
 	return "stony_peaks"
 }
 
+// Spawns ...
+func (StonyPeaks) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (StonyPeaks) EncodeBiome() int {
 	return 189
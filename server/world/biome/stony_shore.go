@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // StonyShore ...
 type StonyShore struct{}
 
@@ -18,6 +20,11 @@ func (StonyShore) String() string {
 	return "stone_beach"
 }
 
+// Spawns ...
+func (StonyShore) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (StonyShore) EncodeBiome() int {
 	return 25
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SunflowerPlains ...
 type SunflowerPlains struct{}
 
@@ -18,6 +20,11 @@ func (SunflowerPlains) String() string {
 	return "sunflower_plains"
 }
 
+// Spawns ...
+func (SunflowerPlains) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (SunflowerPlains) EncodeBiome() int {
 	return 129
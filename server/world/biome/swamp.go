@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Swamp ...
 type Swamp struct{}
 
@@ -18,6 +20,11 @@ func (Swamp) String() string {
 	return "swampland"
 }
 
+// Spawns ...
+func (Swamp) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Swamp) EncodeBiome() int {
 	return 6
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // SwampHills ...
 type SwampHills struct{}
 
@@ -18,6 +20,11 @@ func (SwampHills) String() string {
 	return "swampland_mutated"
 }
 
+// Spawns ...
+func (SwampHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (SwampHills) EncodeBiome() int {
 	return 134
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // Taiga ...
 type Taiga struct{}
 
@@ -18,6 +20,11 @@ func (Taiga) String() string {
 	return "taiga"
 }
 
+// Spawns ...
+func (Taiga) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (Taiga) EncodeBiome() int {
 	return 5
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // TaigaHills ...
 type TaigaHills struct{}
 
@@ -18,6 +20,11 @@ func (TaigaHills) String() string {
 	return "taiga_hills"
 }
 
+// Spawns ...
+func (TaigaHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (TaigaHills) EncodeBiome() int {
 	return 19
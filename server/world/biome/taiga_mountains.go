@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // TaigaMountains ...
 type TaigaMountains struct{}
 
@@ -18,6 +20,11 @@ func (TaigaMountains) String() string {
 	return "taiga_mutated"
 }
 
+// Spawns ...
+func (TaigaMountains) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (TaigaMountains) EncodeBiome() int {
 	return 133
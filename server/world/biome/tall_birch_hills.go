@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // TallBirchHills ...
 type TallBirchHills struct{}
 
@@ -18,6 +20,11 @@ func (TallBirchHills) String() string {
 	return "birch_forest_hills_mutated"
 }
 
+// Spawns ...
+func (TallBirchHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (TallBirchHills) EncodeBiome() int {
 	return 156
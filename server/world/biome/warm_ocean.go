@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WarmOcean ...
 type WarmOcean struct{}
 
@@ -18,6 +20,11 @@ func (WarmOcean) String() string {
 	return "warm_ocean"
 }
 
+// Spawns ...
+func (WarmOcean) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (WarmOcean) EncodeBiome() int {
 	return 40
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WindsweptForest ...
 type WindsweptForest struct{}
 
@@ -18,6 +20,11 @@ func (WindsweptForest) String() string {
 	return "extreme_hills_plus_trees"
 }
 
+// Spawns ...
+func (WindsweptForest) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (WindsweptForest) EncodeBiome() int {
 	return 34
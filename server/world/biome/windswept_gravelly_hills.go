@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WindsweptGravellyHills ...
 type WindsweptGravellyHills struct{}
 
@@ -18,6 +20,11 @@ func (WindsweptGravellyHills) String() string {
 	return "extreme_hills_mutated"
 }
 
+// Spawns ...
+func (WindsweptGravellyHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (WindsweptGravellyHills) EncodeBiome() int {
 	return 131
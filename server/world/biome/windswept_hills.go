@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WindsweptHills ...
 type WindsweptHills struct{}
 
@@ -18,6 +20,11 @@ func (WindsweptHills) String() string {
 	return "extreme_hills"
 }
 
+// Spawns ...
+func (WindsweptHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (WindsweptHills) EncodeBiome() int {
 	return 3
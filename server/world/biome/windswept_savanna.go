@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WindsweptSavanna ...
 type WindsweptSavanna struct{}
 
@@ -18,6 +20,11 @@ func (WindsweptSavanna) String() string {
 	return "savanna_mutated"
 }
 
+// Spawns ...
+func (WindsweptSavanna) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (WindsweptSavanna) EncodeBiome() int {
 	return 163
@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WoodedBadlandsPlateau ...
 type WoodedBadlandsPlateau struct{}
 
@@ -18,6 +20,11 @@ func (WoodedBadlandsPlateau) String() string {
 	return "mesa_plateau_stone"
 }
 
+// Spawns ...
+func (WoodedBadlandsPlateau) Spawns() []world.SpawnEntry {
+	return monsterSpawns
+}
+
 // EncodeBiome ...
 func (WoodedBadlandsPlateau) EncodeBiome() int {
 	return 38
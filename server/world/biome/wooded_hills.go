@@ -1,5 +1,7 @@
 package biome
 
+import "github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/world"
+
 // WoodedHills ...
 type WoodedHills struct{}
 
@@ -18,6 +20,11 @@ func (WoodedHills) String() string {
 	return "forest_hills"
 }
 
+// Spawns ...
+func (WoodedHills) Spawns() []world.SpawnEntry {
+	return overworldSpawns
+}
+
 // EncodeBiome ...
 func (WoodedHills) EncodeBiome() int {
 	return 18
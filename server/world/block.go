@@ -108,7 +108,9 @@ func RegisterBlock(b Block) {
 		nbtBlocks[rid] = true
 	}
 	if _, ok := b.(RandomTicker); ok {
-		randomTickBlocks[rid] = true
+		if c, ok := b.(conditionalRandomTicker); !ok || c.RandomTicks() {
+			randomTickBlocks[rid] = true
+		}
 	}
 	if _, ok := b.(Liquid); ok {
 		liquidBlocks[rid] = true
@@ -116,6 +118,9 @@ func RegisterBlock(b Block) {
 	if _, ok := b.(LiquidDisplacer); ok {
 		liquidDisplacingBlocks[rid] = true
 	}
+	if _, ok := b.(Conductor); ok {
+		conductorBlocks[rid] = true
+	}
 	if c, ok := b.(CustomBlock); ok {
 		if _, ok := customBlocks[name]; !ok {
 			customBlocks[name] = c
@@ -188,6 +193,21 @@ type RandomTicker interface {
 	RandomTick(pos cube.Pos, w *World, r *rand.Rand)
 }
 
+// conditionalRandomTicker is a RandomTicker that only receives random ticks in some of its states, such as a
+// slab that only needs random ticks if it is made of copper.
+type conditionalRandomTicker interface {
+	// RandomTicks checks if the block should receive random ticks.
+	RandomTicks() bool
+}
+
+// Oxidisable represents a block that oxidises over time, such as copper. Lightning striking an Oxidisable block
+// removes oxidation from it and from Oxidisable blocks around it.
+type Oxidisable interface {
+	// Deoxidise returns the block with its oxidation lowered by one stage. If the block cannot be deoxidised, for
+	// example because it is not oxidised at all, false is returned.
+	Deoxidise() (Block, bool)
+}
+
 // ScheduledTicker represents a block that executes an action when it has a block update scheduled, such as
 // when a block adjacent to it is broken.
 type ScheduledTicker interface {
@@ -212,6 +232,27 @@ type NeighbourUpdateTicker interface {
 	NeighbourUpdateTick(pos, changedNeighbour cube.Pos, w *World)
 }
 
+// Conductor represents a block that can emit a redstone signal, such as a lever or a block of redstone. Blocks
+// next to a Conductor receive its power, which may be obtained using World.RedstonePower.
+type Conductor interface {
+	Block
+	// WeakPower returns the redstone power that the Conductor at the position passed emits into the block
+	// directly on its side face. The power ranges from 0 to 15.
+	WeakPower(pos cube.Pos, face cube.Face, w *World) int
+	// StrongPower returns the redstone power that the Conductor at the position passed emits into the block
+	// on its side face, which that block passes on to all of its own neighbours if it is a full, opaque block.
+	// The power ranges from 0 to 15.
+	StrongPower(pos cube.Pos, face cube.Face, w *World) int
+}
+
+// RedstoneUpdater represents a block that is updated when the redstone power around it may have changed, for
+// example as a result of a Conductor being placed, broken or changing state within two blocks of it.
+type RedstoneUpdater interface {
+	// RedstoneUpdate handles a possible change in the redstone power received by the block at the position
+	// passed. World.RedstonePower may be used to obtain the current power.
+	RedstoneUpdate(pos cube.Pos, w *World)
+}
+
 // NBTer represents either an item or a block which may decode NBT data and encode to NBT data. Typically,
 // this is done to store additional data.
 type NBTer interface {
@@ -38,6 +38,9 @@ var (
 	// liquidDisplacingBlocks holds a list of LiquidDisplacer implementations for blocks registered that implement the LiquidDisplacer interface.
 	// These are indexed by their runtime IDs. Blocks that do not implement LiquidDisplacer have a false value in this slice.
 	liquidDisplacingBlocks []bool
+	// conductorBlocks holds a list of Conductor implementations for blocks registered that implement the Conductor interface.
+	// These are indexed by their runtime IDs. Blocks that do not implement Conductor have a false value in this slice.
+	conductorBlocks []bool
 	// airRID is the runtime ID of an air block.
 	airRID uint32
 )
@@ -112,6 +115,7 @@ func registerBlockState(s blockState, order bool) {
 	randomTickBlocks = slices.Insert(randomTickBlocks, int(rid), false)
 	liquidBlocks = slices.Insert(liquidBlocks, int(rid), false)
 	liquidDisplacingBlocks = slices.Insert(liquidDisplacingBlocks, int(rid), false)
+	conductorBlocks = slices.Insert(conductorBlocks, int(rid), false)
 	chunk.FilteringBlocks = slices.Insert(chunk.FilteringBlocks, int(rid), 15)
 	chunk.LightBlocks = slices.Insert(chunk.LightBlocks, int(rid), 0)
 	stateRuntimeIDs[h] = rid
@@ -72,6 +72,7 @@ func (conf Config) New() *World {
 	s := conf.Provider.Settings()
 	w := &World{
 		scheduledUpdates: make(map[cube.Pos]int64),
+		redstoneUpdates:  make(map[cube.Pos]struct{}),
 		entities:         make(map[Entity]ChunkPos),
 		viewers:          make(map[*Loader]Viewer),
 		chunks:           make(map[ChunkPos]*Column),
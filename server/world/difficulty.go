@@ -16,6 +16,45 @@ type Difficulty interface {
 	FireSpreadIncrease() int
 }
 
+// HostileDifficulty is a Difficulty that may prevent hostile mobs from
+// existing. Hostile mobs exist with Difficulties that do not implement
+// HostileDifficulty.
+type HostileDifficulty interface {
+	Difficulty
+	// HostileMobs specifies if hostile mobs exist with this difficulty. If
+	// false, hostile mobs do not spawn and existing ones are removed.
+	HostileMobs() bool
+}
+
+// MobDamageDifficulty is a Difficulty that changes the damage that mobs deal
+// to players. Mobs deal the same damage as on normal difficulty with
+// Difficulties that do not implement MobDamageDifficulty.
+type MobDamageDifficulty interface {
+	Difficulty
+	// MobDamage returns the damage dealt to a player by a mob that deals the
+	// damage passed on normal difficulty.
+	MobDamage(dmg float64) float64
+}
+
+// HostileMobs checks if hostile mobs exist with the Difficulty passed. True is
+// returned if the Difficulty does not implement HostileDifficulty.
+func HostileMobs(diff Difficulty) bool {
+	if h, ok := diff.(HostileDifficulty); ok {
+		return h.HostileMobs()
+	}
+	return true
+}
+
+// MobDamage returns the damage dealt to a player by a mob that deals the
+// damage passed on normal difficulty. The damage is returned unchanged if the
+// Difficulty does not implement MobDamageDifficulty.
+func MobDamage(diff Difficulty, dmg float64) float64 {
+	if m, ok := diff.(MobDamageDifficulty); ok {
+		return m.MobDamage(dmg)
+	}
+	return dmg
+}
+
 var (
 	// DifficultyPeaceful prevents most hostile mobs from spawning and makes
 	// players rapidly regenerate health and food.
@@ -93,6 +132,8 @@ type difficultyPeaceful struct{}
 func (difficultyPeaceful) FoodRegenerates() bool          { return true }
 func (difficultyPeaceful) StarvationHealthLimit() float64 { return 20 }
 func (difficultyPeaceful) FireSpreadIncrease() int        { return 0 }
+func (difficultyPeaceful) HostileMobs() bool              { return false }
+func (difficultyPeaceful) MobDamage(float64) float64      { return 0 }
 
 // difficultyEasy difficulty has mobs deal less damage to players than normal
 // and starvation won't occur if a player has less than 5 hearts of health.
@@ -101,6 +142,8 @@ type difficultyEasy struct{}
 func (difficultyEasy) FoodRegenerates() bool          { return false }
 func (difficultyEasy) StarvationHealthLimit() float64 { return 10 }
 func (difficultyEasy) FireSpreadIncrease() int        { return 7 }
+func (difficultyEasy) HostileMobs() bool              { return true }
+func (difficultyEasy) MobDamage(dmg float64) float64  { return min(dmg/2+1, dmg) }
 
 // difficultyNormal difficulty has mobs that deal normal damage to players.
 // Starvation will occur until the player is down to a single heart.
@@ -109,6 +152,8 @@ type difficultyNormal struct{}
 func (difficultyNormal) FoodRegenerates() bool          { return false }
 func (difficultyNormal) StarvationHealthLimit() float64 { return 2 }
 func (difficultyNormal) FireSpreadIncrease() int        { return 14 }
+func (difficultyNormal) HostileMobs() bool              { return true }
+func (difficultyNormal) MobDamage(dmg float64) float64  { return dmg }
 
 // difficultyHard difficulty has mobs that deal above average damage to
 // players. Starvation will kill players with too little food and monsters will
@@ -118,3 +163,5 @@ type difficultyHard struct{}
 func (difficultyHard) FoodRegenerates() bool          { return false }
 func (difficultyHard) StarvationHealthLimit() float64 { return -1 }
 func (difficultyHard) FireSpreadIncrease() int        { return 21 }
+func (difficultyHard) HostileMobs() bool              { return true }
+func (difficultyHard) MobDamage(dmg float64) float64  { return dmg * 1.5 }
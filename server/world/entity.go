@@ -3,6 +3,7 @@ package world
 import (
 	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
 	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
 	"io"
 	"time"
@@ -59,6 +60,22 @@ type TickerEntity interface {
 	Tick(w *World, current int64)
 }
 
+// Sleeper represents an Entity that is able to sleep in a bed, such as a player. If a large enough part of the
+// Sleepers in a World is asleep during the night, the night is skipped.
+type Sleeper interface {
+	Entity
+	// UUID returns the UUID of the Sleeper. It is used to set the spawn point of the Sleeper.
+	UUID() uuid.UUID
+	// SendTranslation sends a translated message to the Sleeper, such as when it is unable to sleep.
+	SendTranslation(key string, a ...string)
+	// Sleep makes the Sleeper start sleeping in the bed at the position passed.
+	Sleep(pos cube.Pos)
+	// Sleeping returns the position of the bed the Sleeper is sleeping in and true if it is currently sleeping.
+	Sleeping() (cube.Pos, bool)
+	// Wake wakes the Sleeper up if it is currently sleeping.
+	Wake()
+}
+
 // EntityAction represents an action that may be performed by an entity. Typically, these actions are sent to
 // viewers in a world so that they can see these actions.
 type EntityAction interface {
@@ -111,6 +128,11 @@ type EntityRegistryConfig struct {
 	Snowball           func(pos, vel mgl64.Vec3, owner Entity) Entity
 	SplashPotion       func(pos, vel mgl64.Vec3, t any, owner Entity) Entity
 	Lightning          func(pos mgl64.Vec3) Entity
+	Boat               func(pos mgl64.Vec3, yaw float64, wood any, chest bool) Entity
+	Minecart           func(pos mgl64.Vec3) Entity
+	ChestMinecart      func(pos mgl64.Vec3) Entity
+	HopperMinecart     func(pos mgl64.Vec3) Entity
+	TNTMinecart        func(pos mgl64.Vec3) Entity
 }
 
 // New creates an EntityRegistry using conf and the EntityTypes passed.
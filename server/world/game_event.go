@@ -0,0 +1,288 @@
+package world
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/event"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+// GameEvent is an event that happens in a World and causes a vibration, such as an entity taking a step or a
+// block being placed. GameEvents may be received by GameEventListeners around the position they were emitted at,
+// such as sculk sensors.
+type GameEvent struct {
+	gameEvent
+}
+
+// GameEventStep is emitted when an entity takes a step on the ground.
+func GameEventStep() GameEvent {
+	return GameEvent{0}
+}
+
+// GameEventSwim is emitted when an entity swims through water.
+func GameEventSwim() GameEvent {
+	return GameEvent{1}
+}
+
+// GameEventProjectileLand is emitted when a projectile lands on a block.
+func GameEventProjectileLand() GameEvent {
+	return GameEvent{2}
+}
+
+// GameEventHitGround is emitted when an entity lands on the ground after falling.
+func GameEventHitGround() GameEvent {
+	return GameEvent{3}
+}
+
+// GameEventItemPickup is emitted when an entity picks up an item.
+func GameEventItemPickup() GameEvent {
+	return GameEvent{4}
+}
+
+// GameEventProjectileShoot is emitted when an entity shoots a projectile, such as an arrow from a bow.
+func GameEventProjectileShoot() GameEvent {
+	return GameEvent{5}
+}
+
+// GameEventEat is emitted when an entity finishes eating or drinking an item.
+func GameEventEat() GameEvent {
+	return GameEvent{6}
+}
+
+// GameEventContainerClose is emitted when a container block, such as a chest, is closed.
+func GameEventContainerClose() GameEvent {
+	return GameEvent{7}
+}
+
+// GameEventContainerOpen is emitted when a container block, such as a chest, is opened.
+func GameEventContainerOpen() GameEvent {
+	return GameEvent{8}
+}
+
+// GameEventBlockActivate is emitted when a block is activated, such as a lever being flipped.
+func GameEventBlockActivate() GameEvent {
+	return GameEvent{9}
+}
+
+// GameEventSculkSensorActivate is emitted when a sculk sensor is activated by a vibration. Sculk sensors
+// themselves do not respond to it, but sculk shriekers do.
+func GameEventSculkSensorActivate() GameEvent {
+	return GameEvent{10}
+}
+
+// GameEventBlockDestroy is emitted when a block is broken.
+func GameEventBlockDestroy() GameEvent {
+	return GameEvent{11}
+}
+
+// GameEventBlockPlace is emitted when a block is placed.
+func GameEventBlockPlace() GameEvent {
+	return GameEvent{12}
+}
+
+// GameEventLightningStrike is emitted when lightning strikes.
+func GameEventLightningStrike() GameEvent {
+	return GameEvent{13}
+}
+
+// GameEventEntityDie is emitted when an entity dies.
+func GameEventEntityDie() GameEvent {
+	return GameEvent{14}
+}
+
+// GameEventExplode is emitted when an explosion happens.
+func GameEventExplode() GameEvent {
+	return GameEvent{15}
+}
+
+// GameEvents returns all GameEvents.
+func GameEvents() []GameEvent {
+	return []GameEvent{
+		GameEventStep(), GameEventSwim(), GameEventProjectileLand(), GameEventHitGround(), GameEventItemPickup(),
+		GameEventProjectileShoot(), GameEventEat(), GameEventContainerClose(), GameEventContainerOpen(),
+		GameEventBlockActivate(), GameEventSculkSensorActivate(), GameEventBlockDestroy(), GameEventBlockPlace(),
+		GameEventLightningStrike(), GameEventEntityDie(), GameEventExplode(),
+	}
+}
+
+type gameEvent uint8
+
+// Uint8 returns the game event as a uint8.
+func (g gameEvent) Uint8() uint8 {
+	return uint8(g)
+}
+
+// Frequency returns the frequency of the vibration caused by the game event, ranging from 1 to 15. Sculk sensors
+// output a redstone signal with a strength equal to the frequency of the last vibration they received.
+func (g gameEvent) Frequency() int {
+	switch g {
+	case 0, 1:
+		return 1
+	case 2, 3:
+		return 2
+	case 4, 5:
+		return 3
+	case 6:
+		return 8
+	case 7:
+		return 9
+	case 8, 9, 10:
+		return 10
+	case 11:
+		return 12
+	case 12:
+		return 13
+	case 13:
+		return 14
+	case 14, 15:
+		return 15
+	}
+	panic("unknown game event")
+}
+
+// String ...
+func (g gameEvent) String() string {
+	switch g {
+	case 0:
+		return "step"
+	case 1:
+		return "swim"
+	case 2:
+		return "projectile_land"
+	case 3:
+		return "hit_ground"
+	case 4:
+		return "item_pickup"
+	case 5:
+		return "projectile_shoot"
+	case 6:
+		return "eat"
+	case 7:
+		return "container_close"
+	case 8:
+		return "container_open"
+	case 9:
+		return "block_activate"
+	case 10:
+		return "sculk_sensor_activate"
+	case 11:
+		return "block_destroy"
+	case 12:
+		return "block_place"
+	case 13:
+		return "lightning_strike"
+	case 14:
+		return "entity_die"
+	case 15:
+		return "explode"
+	}
+	panic("unknown game event")
+}
+
+// Vibration is the vibration caused by a GameEvent, as received by a GameEventListener.
+type Vibration struct {
+	// Event is the GameEvent that caused the vibration.
+	Event GameEvent
+	// Pos is the position that the GameEvent was emitted at.
+	Pos mgl64.Vec3
+	// Source is the entity that caused the GameEvent. Source is nil if the GameEvent was not caused by an entity.
+	Source Entity
+}
+
+// GameEventListener represents a block that receives GameEvents emitted within a range around it, such as a sculk
+// sensor. GameEventListeners must be blocks that have block entity data, i.e. implement NBTer.
+type GameEventListener interface {
+	// GameEventRange returns the range in blocks around the listener within which it receives GameEvents. The
+	// range may not exceed 16 blocks.
+	GameEventRange() float64
+	// ReceiveGameEvent handles a Vibration received by the listener at the position passed. ReceiveGameEvent is
+	// only called if the listener is within range of the GameEvent and no VibrationOccluder is between them.
+	ReceiveGameEvent(pos cube.Pos, v Vibration, w *World)
+}
+
+// VibrationOccluder represents a block, such as wool, that blocks vibrations from passing through it. A GameEvent
+// is not received by a GameEventListener if a VibrationOccluder is found between them. Additionally, entities
+// stepping on a VibrationOccluder do not cause vibrations.
+type VibrationOccluder interface {
+	// OccludesVibrations returns true if the block blocks vibrations.
+	OccludesVibrations() bool
+}
+
+// maxGameEventRange is the maximum range that a GameEventListener may receive GameEvents from.
+const maxGameEventRange = 16
+
+// EmitGameEvent emits a GameEvent at the position passed. The source passed is the entity that caused the
+// GameEvent, or nil if it was not caused by an entity. The Handler of the World is called first, after which all
+// GameEventListeners in range of the position, in loaded chunks, receive the vibration.
+func (w *World) EmitGameEvent(pos mgl64.Vec3, e GameEvent, source Entity) {
+	if w == nil {
+		return
+	}
+	ctx := event.C()
+	if w.Handler().HandleGameEvent(ctx, e, pos, source); ctx.Cancelled() {
+		return
+	}
+	v := Vibration{Event: e, Pos: pos, Source: source}
+	for _, listenerPos := range w.gameEventListeners(pos) {
+		l, ok := w.Block(listenerPos).(GameEventListener)
+		if !ok {
+			continue
+		}
+		centre := listenerPos.Vec3Centre()
+		if centre.Sub(pos).Len() > math.Min(l.GameEventRange(), maxGameEventRange) || w.vibrationOccluded(pos, centre) {
+			continue
+		}
+		l.ReceiveGameEvent(listenerPos, v, w)
+	}
+}
+
+// gameEventListeners returns the positions of all GameEventListeners in loaded chunks within the maximum game
+// event range of the position passed.
+func (w *World) gameEventListeners(pos mgl64.Vec3) (positions []cube.Pos) {
+	minPos := chunkPosFromVec3(pos.Sub(mgl64.Vec3{maxGameEventRange, 0, maxGameEventRange}))
+	maxPos := chunkPosFromVec3(pos.Add(mgl64.Vec3{maxGameEventRange, 0, maxGameEventRange}))
+
+	columns := make([]*Column, 0, 4)
+	w.chunkMu.Lock()
+	for x := minPos[0]; x <= maxPos[0]; x++ {
+		for z := minPos[1]; z <= maxPos[1]; z++ {
+			if c, ok := w.chunks[ChunkPos{x, z}]; ok {
+				columns = append(columns, c)
+			}
+		}
+	}
+	w.chunkMu.Unlock()
+
+	for _, c := range columns {
+		c.Lock()
+		for blockPos, b := range c.BlockEntities {
+			if _, ok := b.(GameEventListener); ok {
+				positions = append(positions, blockPos)
+			}
+		}
+		c.Unlock()
+	}
+	return positions
+}
+
+// vibrationOccluded checks if any VibrationOccluder is found between the two positions passed. The blocks that the
+// positions themselves are in are not checked.
+func (w *World) vibrationOccluded(from, to mgl64.Vec3) bool {
+	start, end := cube.PosFromVec3(from), cube.PosFromVec3(to)
+	diff := to.Sub(from)
+	// Sample the line between the two positions four times per block, which is precise enough to find any block
+	// that the line passes through in a meaningful way.
+	steps := int(math.Ceil(diff.Len() * 4))
+	last := start
+	for i := 1; i < steps; i++ {
+		pos := cube.PosFromVec3(from.Add(diff.Mul(float64(i) / float64(steps))))
+		if pos == last || pos == start || pos == end {
+			continue
+		}
+		last = pos
+		if o, ok := w.Block(pos).(VibrationOccluder); ok && o.OccludesVibrations() {
+			return true
+		}
+	}
+	return false
+}
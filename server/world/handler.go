@@ -26,6 +26,10 @@ type Handler interface {
 	// HandleSound handles a Sound being played in the World at a specific position. ctx.Cancel() may be called
 	// to stop the Sound from playing to viewers of the position.
 	HandleSound(ctx *event.Context, s Sound, pos mgl64.Vec3)
+	// HandleGameEvent handles a GameEvent being emitted in the World at a specific position. The source is the
+	// entity that caused the GameEvent, or nil if it was not caused by an entity. ctx.Cancel() may be called to
+	// prevent GameEventListeners, such as sculk sensors, from receiving the GameEvent.
+	HandleGameEvent(ctx *event.Context, e GameEvent, pos mgl64.Vec3, source Entity)
 	// HandleFireSpread handles when a fire block spreads from one block to another block. When this event handler gets
 	// called, both the position of the original fire will be passed, and the position where it will spread to after the
 	// event. The age of the fire may also be altered by changing the underlying value of the newFireAge pointer, which
@@ -57,6 +61,7 @@ func (NopHandler) HandleLiquidFlow(*event.Context, cube.Pos, cube.Pos, Liquid, B
 func (NopHandler) HandleLiquidDecay(*event.Context, cube.Pos, Liquid, Liquid)         {}
 func (NopHandler) HandleLiquidHarden(*event.Context, cube.Pos, Block, Block, Block)   {}
 func (NopHandler) HandleSound(*event.Context, Sound, mgl64.Vec3)                      {}
+func (NopHandler) HandleGameEvent(*event.Context, GameEvent, mgl64.Vec3, Entity)      {}
 func (NopHandler) HandleFireSpread(*event.Context, cube.Pos, cube.Pos)                {}
 func (NopHandler) HandleBlockBurn(*event.Context, cube.Pos)                           {}
 func (NopHandler) HandleEntitySpawn(Entity)                                           {}
@@ -195,6 +195,7 @@ func (d *Data) FillDefault() {
 	d.NetherScale = 8
 	d.NetworkVersion = protocol.CurrentProtocol
 	d.PVP = true
+	d.PlayersSleepingPercentage = 100
 	d.Platform = 2
 	d.PlatformBroadcastIntent = 3
 	d.RainLevel = 1.0
@@ -235,6 +236,8 @@ func (d *Data) Settings() *world.Settings {
 		DefaultGameMode: mode,
 		Difficulty:      difficulty,
 		TickRange:       d.ServerChunkTickRange,
+
+		PlayersSleepingPercentage: d.PlayersSleepingPercentage,
 	}
 }
 
@@ -256,6 +259,7 @@ func (d *Data) PutSettings(s *world.Settings) {
 	}
 	d.CurrentTick = s.CurrentTick
 	d.ServerChunkTickRange = s.TickRange
+	d.PlayersSleepingPercentage = s.PlayersSleepingPercentage
 	mode, _ := world.GameModeID(s.DefaultGameMode)
 	d.GameType = int32(mode)
 	difficulty, _ := world.DifficultyID(s.Difficulty)
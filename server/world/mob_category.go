@@ -0,0 +1,73 @@
+package world
+
+// MobCategory is a category of mobs that are spawned naturally in a World. Each category has its own cap on the
+// number of mobs that may be present around loaders and its own rules for where its mobs may spawn.
+type MobCategory struct {
+	mobCategory
+}
+
+// MonsterCategory returns the MobCategory of hostile mobs, such as zombies and skeletons. Monsters spawn in the
+// dark and only if the Difficulty of the World allows hostile mobs.
+func MonsterCategory() MobCategory {
+	return MobCategory{0}
+}
+
+// CreatureCategory returns the MobCategory of passive land mobs, such as cows and sheep. Creatures spawn on the
+// surface in well-lit areas.
+func CreatureCategory() MobCategory {
+	return MobCategory{1}
+}
+
+// AmbientCategory returns the MobCategory of ambient mobs, such as bats. Ambient mobs spawn in dark places below
+// sea level.
+func AmbientCategory() MobCategory {
+	return MobCategory{2}
+}
+
+// WaterCategory returns the MobCategory of mobs that live in water, such as squids. Water mobs spawn in water.
+func WaterCategory() MobCategory {
+	return MobCategory{3}
+}
+
+// MobCategories returns all MobCategories.
+func MobCategories() []MobCategory {
+	return []MobCategory{MonsterCategory(), CreatureCategory(), AmbientCategory(), WaterCategory()}
+}
+
+type mobCategory uint8
+
+// Uint8 returns the mob category as a uint8.
+func (c mobCategory) Uint8() uint8 {
+	return uint8(c)
+}
+
+// Cap returns the maximum number of mobs of the category that may be present in a 17x17 chunk area around
+// loaders. The cap scales with the number of chunks that are eligible for spawning.
+func (c mobCategory) Cap() int {
+	switch c {
+	case 0:
+		return 70
+	case 1:
+		return 10
+	case 2:
+		return 15
+	case 3:
+		return 5
+	}
+	panic("unknown mob category")
+}
+
+// String returns the mob category as a string.
+func (c mobCategory) String() string {
+	switch c {
+	case 0:
+		return "monster"
+	case 1:
+		return "creature"
+	case 2:
+		return "ambient"
+	case 3:
+		return "water"
+	}
+	panic("unknown mob category")
+}
@@ -41,6 +41,13 @@ type Settings struct {
 	// TickRange is the radius in chunks around a Viewer that has its blocks and entities ticked when the world is
 	// ticked. If set to 0, blocks and entities will never be ticked.
 	TickRange int32
+	// MobSpawning specifies if mobs should spawn naturally in the World. If set to false, no mobs will spawn, but
+	// mobs that are far away from players are still despawned. Mob spawning is disabled by default. MobSpawning
+	// is separate from the mob spawning game rule in the level.dat of a world and is not saved.
+	MobSpawning bool
+	// PlayersSleepingPercentage is the percentage of players in the World that must be sleeping for the night to be
+	// skipped. If set to 0, a single sleeping player is enough to skip the night.
+	PlayersSleepingPercentage int32
 }
 
 // defaultSettings returns the default Settings for a new World.
@@ -52,5 +59,7 @@ func defaultSettings() *Settings {
 		TimeCycle:       true,
 		WeatherCycle:    true,
 		TickRange:       6,
+
+		PlayersSleepingPercentage: 100,
 	}
 }
@@ -120,6 +120,27 @@ type DoorCrash struct{ sound }
 // Click is a clicking sound.
 type Click struct{ sound }
 
+// PowerOn is a sound played when a redstone component, such as a lever, is switched on.
+type PowerOn struct{ sound }
+
+// PowerOff is a sound played when a redstone component, such as a lever, is switched off.
+type PowerOff struct{ sound }
+
+// RespawnAnchorCharge is a sound played when a respawn anchor is charged using glowstone.
+type RespawnAnchorCharge struct{ sound }
+
+// RespawnAnchorDeplete is a sound played when a charge of a respawn anchor is used up by a player respawning.
+type RespawnAnchorDeplete struct{ sound }
+
+// RespawnAnchorSetSpawn is a sound played when a player sets its spawn point using a respawn anchor.
+type RespawnAnchorSetSpawn struct{ sound }
+
+// ShulkerBoxOpen is a sound played when a shulker box is opened.
+type ShulkerBoxOpen struct{ sound }
+
+// ShulkerBoxClose is a sound played when a shulker box is closed.
+type ShulkerBoxClose struct{ sound }
+
 // Ignite is a sound played when using a flint & steel.
 type Ignite struct{ sound }
 
@@ -129,6 +150,9 @@ type TNT struct{ sound }
 // FireExtinguish is a sound played when a fire is extinguished.
 type FireExtinguish struct{ sound }
 
+// CampfireCrackle is a sound played occasionally by a lit campfire.
+type CampfireCrackle struct{ sound }
+
 // Note is a sound played by note blocks.
 type Note struct {
 	sound
@@ -164,6 +188,40 @@ type FurnaceCrackle struct{ sound }
 // BlastFurnaceCrackle is a sound played every one to five seconds from a blast furnace.
 type BlastFurnaceCrackle struct{ sound }
 
+// PotionBrewed is a sound played when a brewing stand finishes brewing potions.
+type PotionBrewed struct{ sound }
+
+// CauldronFillWater is a sound played when water is added to a cauldron.
+type CauldronFillWater struct{ sound }
+
+// CauldronTakeWater is a sound played when water is taken out of a cauldron.
+type CauldronTakeWater struct{ sound }
+
+// CauldronFillPotion is a sound played when a potion is added to a cauldron.
+type CauldronFillPotion struct{ sound }
+
+// CauldronTakePotion is a sound played when a potion is taken out of a cauldron.
+type CauldronTakePotion struct{ sound }
+
+// CauldronAddDye is a sound played when dye is added to the water in a cauldron.
+type CauldronAddDye struct{ sound }
+
+// CauldronDyeArmour is a sound played when leather armour is dyed using the dyed water in a cauldron.
+type CauldronDyeArmour struct{ sound }
+
+// CauldronCleanArmour is a sound played when the dye is washed off leather armour or a banner in a cauldron.
+type CauldronCleanArmour struct{ sound }
+
+// CauldronExplode is a sound played when two different potions are mixed in a cauldron, emptying it.
+type CauldronExplode struct{ sound }
+
+// CauldronDrip is a sound played when a drop of liquid drips from dripstone into a cauldron.
+type CauldronDrip struct {
+	sound
+	// Lava is true if the drop dripping into the cauldron is lava.
+	Lava bool
+}
+
 // SmokerCrackle is a sound played every one to five seconds from a smoker.
 type SmokerCrackle struct{ sound }
 
@@ -185,6 +243,39 @@ type LecternBookPlace struct{ sound }
 // SignWaxed is a sound played when a sign is waxed.
 type SignWaxed struct{ sound }
 
+// WaxRemoved is a sound played when wax is scraped off a copper block using an axe.
+type WaxRemoved struct{ sound }
+
+// CopperScraped is a sound played when oxidation is scraped off a copper block using an axe.
+type CopperScraped struct{ sound }
+
+// AmethystChime is a sound played when an entity walks on amethyst.
+type AmethystChime struct{ sound }
+
+// BeehiveEnter is a sound played when an entity enters a beehive or bee nest.
+type BeehiveEnter struct{ sound }
+
+// BeehiveExit is a sound played when an entity leaves a beehive or bee nest.
+type BeehiveExit struct{ sound }
+
+// BeehiveShear is a sound played when honeycomb is sheared off a beehive or bee nest.
+type BeehiveShear struct{ sound }
+
+// SculkSensorPowerOn is a sound played when a sculk sensor detects a vibration and activates.
+type SculkSensorPowerOn struct{ sound }
+
+// SculkSensorPowerOff is a sound played when a sculk sensor deactivates after detecting a vibration.
+type SculkSensorPowerOff struct{ sound }
+
+// SculkCatalystBloom is a sound played when a sculk catalyst blooms after an entity died near it.
+type SculkCatalystBloom struct{ sound }
+
+// SculkShriek is a sound played when a sculk shrieker shrieks.
+type SculkShriek struct{ sound }
+
+// SculkSpread is a sound played when sculk spreads over a block.
+type SculkSpread struct{ sound }
+
 // WaxedSignFailedInteraction is a sound played when a player tries to interact with a waxed sign.
 type WaxedSignFailedInteraction struct{ sound }
 
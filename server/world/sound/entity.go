@@ -58,3 +58,12 @@ type FireworkBlast struct{ sound }
 
 // FireworkTwinkle is a sound played when a firework explodes and should twinkle.
 type FireworkTwinkle struct{ sound }
+
+// Milk is a sound played when a cow is milked using a bucket.
+type Milk struct{ sound }
+
+// Shear is a sound played when a sheep is sheared using shears.
+type Shear struct{ sound }
+
+// Saddle is a sound played when a saddle is put on an entity, such as a pig.
+type Saddle struct{ sound }
@@ -0,0 +1,282 @@
+package world
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+// SpawnEntry is an entry in the spawn list of a Biome. It specifies an entity type that may spawn naturally in the
+// Biome and how often it spawns compared to the other entries of its MobCategory.
+type SpawnEntry struct {
+	// Entity is the name of the entity type, as returned by EntityType.EncodeEntity. Entries of which the entity
+	// type is not registered in the EntityRegistry of the World, or does not implement SpawnableEntityType, are
+	// ignored.
+	Entity string
+	// Weight is the weight of the entry. The higher the weight, the more likely the entry is picked over other
+	// entries of the same MobCategory.
+	Weight int
+	// MinGroup and MaxGroup are the minimum and maximum amount of entities that are spawned at once.
+	MinGroup, MaxGroup int
+}
+
+// SpawnableEntityType is an EntityType of which entities may be spawned naturally in a World.
+type SpawnableEntityType interface {
+	EntityType
+	// MobCategory returns the MobCategory of the entity type. The MobCategory decides the cap on the amount of
+	// entities of the type and the light and space requirements for spawning it.
+	MobCategory() MobCategory
+	// CanSpawn checks if an entity of the type may spawn at the position passed. CanSpawn is only called if the
+	// requirements of the MobCategory are met and may be used to add further requirements, such as the block
+	// that the entity spawns on.
+	CanSpawn(pos cube.Pos, w *World) bool
+	// Spawn creates a new entity of the type at the position passed.
+	Spawn(pos mgl64.Vec3) Entity
+}
+
+// persistent is implemented by entities that may or may not be despawned when far away from players. Entities
+// with a SpawnableEntityType that do not implement persistent are always despawned when far away.
+type persistent interface {
+	// Persistent checks if the entity should never be despawned.
+	Persistent() bool
+}
+
+const (
+	// spawnChunkRadius is the maximum radius in chunks around a loader that mobs are spawned in.
+	spawnChunkRadius = 8
+	// spawnChunkArea is the amount of chunks in the area that the caps of MobCategories apply to.
+	spawnChunkArea = 17 * 17
+	// minSpawnDistance is the minimum distance from any player at which mobs are spawned.
+	minSpawnDistance = 24
+	// despawnDistance is the distance from the nearest player beyond which mobs are despawned immediately.
+	despawnDistance = 128
+	// randomDespawnDistance is the distance from the nearest player beyond which mobs have a chance to be
+	// despawned.
+	randomDespawnDistance = 32
+)
+
+// tickMobSpawning despawns mobs that are far away from players and spawns new mobs in chunks around the loaders
+// passed, as long as the caps of their MobCategories have not been reached.
+func (t ticker) tickMobSpawning(loaders []*Loader, tick int64) {
+	if tick%20 != 0 {
+		return
+	}
+	var players []mgl64.Vec3
+	entities := t.w.Entities()
+	for _, e := range entities {
+		if e.Type().EncodeEntity() == "minecraft:player" {
+			players = append(players, e.Position())
+		}
+	}
+	if len(players) == 0 {
+		return
+	}
+	counts := t.despawnMobs(entities, players)
+	if !t.w.MobSpawning() {
+		return
+	}
+	chunks := t.spawnChunks(loaders)
+	if len(chunks) == 0 {
+		return
+	}
+
+	for _, cat := range MobCategories() {
+		if cat == MonsterCategory() && !HostileMobs(t.w.Difficulty()) {
+			continue
+		}
+		if cat == CreatureCategory() && tick%400 != 0 {
+			// Creatures don't despawn, so they are spawned a lot less frequently than other mobs.
+			continue
+		}
+		limit := cat.Cap() * len(chunks) / spawnChunkArea
+		for pos := range chunks {
+			if counts[cat] >= limit {
+				break
+			}
+			counts[cat] += t.spawnGroup(pos, cat, chunks, players, limit-counts[cat])
+		}
+	}
+}
+
+// despawnMobs despawns all naturally spawnable entities out of the entities passed that are not persistent and
+// are far away from all players. The amount of remaining entities of each MobCategory is returned.
+func (t ticker) despawnMobs(entities []Entity, players []mgl64.Vec3) map[MobCategory]int {
+	counts := make(map[MobCategory]int, 4)
+	for _, e := range entities {
+		st, ok := e.Type().(SpawnableEntityType)
+		if !ok {
+			continue
+		}
+		if p, ok := e.(persistent); !ok || !p.Persistent() {
+			dist := nearestDistance(e.Position(), players)
+			// Mobs have a 1/800 chance to be despawned every tick when further than randomDespawnDistance away.
+			// We only check every 20 ticks, so the chance is multiplied by 20.
+			if dist > despawnDistance || (dist > randomDespawnDistance && t.w.r.Intn(40) == 0) {
+				_ = e.Close()
+				continue
+			}
+		}
+		counts[st.MobCategory()]++
+	}
+	return counts
+}
+
+// spawnChunks returns all loaded chunks within the spawn radius of the loaders passed.
+func (t ticker) spawnChunks(loaders []*Loader) map[ChunkPos]struct{} {
+	r := min(int32(t.w.tickRange()), spawnChunkRadius)
+	if r == 0 {
+		return nil
+	}
+	loaded := make([]ChunkPos, 0, len(loaders))
+	for _, loader := range loaders {
+		loader.mu.RLock()
+		loaded = append(loaded, loader.pos)
+		loader.mu.RUnlock()
+	}
+
+	chunks := make(map[ChunkPos]struct{})
+	t.w.chunkMu.Lock()
+	for pos := range t.w.chunks {
+		if t.anyWithinDistance(pos, loaded, r) {
+			chunks[pos] = struct{}{}
+		}
+	}
+	t.w.chunkMu.Unlock()
+	return chunks
+}
+
+// spawnGroup attempts to spawn a group of mobs of the MobCategory passed at a random position in the chunk at
+// the ChunkPos passed. Mobs are only spawned in the chunks passed and at least minSpawnDistance away from the
+// players passed. No more than n mobs are spawned. The amount of mobs spawned is returned.
+func (t ticker) spawnGroup(chunk ChunkPos, cat MobCategory, chunks map[ChunkPos]struct{}, players []mgl64.Vec3, n int) int {
+	x, z := int(chunk[0]<<4)+t.w.r.Intn(16), int(chunk[1]<<4)+t.w.r.Intn(16)
+	minY, y := t.w.Range().Min(), t.w.HighestBlock(x, z)+1
+	if cat != CreatureCategory() {
+		// Creatures spawn on the surface, but all other mobs may spawn anywhere below the highest block.
+		y = minY + t.w.r.Intn(y-minY+1)
+	}
+	typ, entry, ok := t.spawnEntry(t.w.Biome(cube.Pos{x, y, z}), cat)
+	if !ok {
+		return 0
+	}
+	size := entry.MinGroup
+	if entry.MaxGroup > entry.MinGroup {
+		size += t.w.r.Intn(entry.MaxGroup - entry.MinGroup + 1)
+	}
+
+	spawned := 0
+	for i := 0; i < size && spawned < n; i++ {
+		pos := cube.Pos{x + t.w.r.Intn(6) - t.w.r.Intn(6), y, z + t.w.r.Intn(6) - t.w.r.Intn(6)}
+		if _, ok := chunks[chunkPosFromBlockPos(pos)]; !ok || pos.OutOfBounds(t.w.Range()) {
+			continue
+		}
+		vec := mgl64.Vec3{float64(pos[0]) + 0.5, float64(pos[1]), float64(pos[2]) + 0.5}
+		if nearestDistance(vec, players) < minSpawnDistance || !t.spawnable(pos, cat) || !typ.CanSpawn(pos, t.w) {
+			continue
+		}
+		t.w.AddEntity(typ.Spawn(vec))
+		spawned++
+	}
+	return spawned
+}
+
+// spawnEntry picks a random SpawnEntry of the MobCategory passed from the spawn list of the Biome passed. The
+// chance of an entry being picked is proportional to its weight. False is returned if the Biome is not a
+// SpawningBiome or has no entries of the MobCategory.
+func (t ticker) spawnEntry(b Biome, cat MobCategory) (SpawnableEntityType, SpawnEntry, bool) {
+	var (
+		types   []SpawnableEntityType
+		entries []SpawnEntry
+		total   int
+	)
+	sb, ok := b.(SpawningBiome)
+	if !ok {
+		return nil, SpawnEntry{}, false
+	}
+	for _, entry := range sb.Spawns() {
+		et, ok := t.w.conf.Entities.Lookup(entry.Entity)
+		if !ok || entry.Weight <= 0 {
+			continue
+		}
+		if st, ok := et.(SpawnableEntityType); ok && st.MobCategory() == cat {
+			types, entries, total = append(types, st), append(entries, entry), total+entry.Weight
+		}
+	}
+	if total == 0 {
+		return nil, SpawnEntry{}, false
+	}
+	n := t.w.r.Intn(total)
+	for i, entry := range entries {
+		if n -= entry.Weight; n < 0 {
+			return types[i], entry, true
+		}
+	}
+	panic("should never happen")
+}
+
+// spawnable checks if a mob of the MobCategory passed may spawn at a position, based on the light level and the
+// blocks at and around the position.
+func (t ticker) spawnable(pos cube.Pos, cat MobCategory) bool {
+	above := pos.Side(cube.FaceUp)
+	if cat == WaterCategory() {
+		return t.water(pos) && t.water(above)
+	}
+	below := pos.Side(cube.FaceDown)
+	if !t.w.Block(below).Model().FaceSolid(below, cube.FaceUp, t.w) || !t.passable(pos) || !t.passable(above) {
+		return false
+	}
+	switch cat {
+	case MonsterCategory():
+		return t.w.BlockLight(pos) == 0 && int(t.w.SkyLight(pos))-t.w.skyDarkness() <= t.w.r.Intn(8)
+	case CreatureCategory():
+		return t.w.Light(pos) >= 9
+	default:
+		return pos[1] < 63 && max(int(t.w.BlockLight(pos)), int(t.w.SkyLight(pos))-t.w.skyDarkness()) <= t.w.r.Intn(4)
+	}
+}
+
+// passable checks if the block at a position has no collision boxes and holds no liquid, so that mobs may spawn
+// in it.
+func (t ticker) passable(pos cube.Pos) bool {
+	if _, ok := t.w.Liquid(pos); ok {
+		return false
+	}
+	return len(t.w.Block(pos).Model().BBox(pos, t.w)) == 0
+}
+
+// water checks if the block at a position holds water.
+func (t ticker) water(pos cube.Pos) bool {
+	l, ok := t.w.Liquid(pos)
+	return ok && l.LiquidType() == "water"
+}
+
+// skyDarkness returns the amount by which the sky light in the World is reduced because of the time of day and
+// the weather.
+func (w *World) skyDarkness() int {
+	if !w.Dimension().TimeCycle() {
+		return 0
+	}
+	w.set.Lock()
+	tim, raining, thundering := w.set.Time%24000, w.set.Raining, w.set.Thundering && w.set.Raining
+	w.set.Unlock()
+
+	darkness := 0
+	if tim >= 12542 && tim < 23460 {
+		darkness = 11
+	}
+	if thundering {
+		darkness = max(darkness, 5)
+	} else if raining {
+		darkness = max(darkness, 3)
+	}
+	return darkness
+}
+
+// nearestDistance returns the distance from the position passed to the nearest of the positions passed.
+func nearestDistance(pos mgl64.Vec3, positions []mgl64.Vec3) float64 {
+	dist := math.MaxFloat64
+	for _, p := range positions {
+		dist = min(dist, p.Sub(pos).Len())
+	}
+	return dist
+}
@@ -64,14 +64,57 @@ func (t ticker) tick() {
 			}
 		}
 	}
+	if rain {
+		t.w.tickPrecipitation()
+	}
 	if thunder {
 		t.w.tickLightning()
 	}
+	if t.w.conf.Dim.TimeCycle() {
+		t.tickSleeping(tim)
+	}
 
 	t.tickEntities(tick)
+	t.tickMobSpawning(loaders, tick)
 	t.tickBlocksRandomly(loaders, tick)
 	t.tickScheduledBlocks(tick)
 	t.performNeighbourUpdates()
+	t.performRedstoneUpdates()
+}
+
+// sleepDuration is the amount of ticks that Sleepers must have been asleep for before the night is skipped.
+const sleepDuration = 100
+
+// tickSleeping skips the night if the percentage of Sleepers in the World set in the Settings has been asleep for
+// long enough. All Sleepers are woken up and the weather is cleared when the night is skipped.
+func (t ticker) tickSleeping(tim int) {
+	var sleepers []Sleeper
+	asleep := 0
+	for _, e := range t.w.Entities() {
+		if s, ok := e.(Sleeper); ok {
+			sleepers = append(sleepers, s)
+			if _, ok := s.Sleeping(); ok {
+				asleep++
+			}
+		}
+	}
+	pct := t.w.PlayersSleepingPercentage()
+	required := max(1, (len(sleepers)*pct+99)/100)
+	if pct > 100 || asleep == 0 || asleep < required {
+		t.w.sleepTicks = 0
+		return
+	}
+	if t.w.sleepTicks++; t.w.sleepTicks < sleepDuration {
+		return
+	}
+	t.w.sleepTicks = 0
+
+	const day = 24000
+	t.w.SetTime(tim + day - tim%day)
+	t.w.StopRaining()
+	for _, s := range sleepers {
+		s.Wake()
+	}
 }
 
 // tickScheduledBlocks executes scheduled block updates in chunks that are currently loaded.
@@ -118,6 +161,20 @@ func (t ticker) performNeighbourUpdates() {
 	}
 }
 
+// performRedstoneUpdates performs all redstone updates that came as a result of a Conductor nearby being changed.
+func (t ticker) performRedstoneUpdates() {
+	t.w.updateMu.Lock()
+	positions := maps.Keys(t.w.redstoneUpdates)
+	clear(t.w.redstoneUpdates)
+	t.w.updateMu.Unlock()
+
+	for _, pos := range positions {
+		if updater, ok := t.w.Block(pos).(RedstoneUpdater); ok {
+			updater.RedstoneUpdate(pos, t.w)
+		}
+	}
+}
+
 // tickBlocksRandomly executes random block ticks in each sub chunk in the world that has at least one viewer
 // registered from the viewers passed.
 func (t ticker) tickBlocksRandomly(loaders []*Loader, tick int64) {
@@ -0,0 +1,74 @@
+package world
+
+import (
+	"github.com/Adrian8115/dragonfly-Amethyst-Protocol/server/block/cube"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Vehicle is an Entity that other entities, riders, are able to ride. Examples of vehicles are pigs, boats and
+// minecarts.
+type Vehicle interface {
+	Entity
+	// Passengers returns the riders currently riding the vehicle in the order of the seats they occupy. The first
+	// passenger, if any, is the driver of the vehicle.
+	Passengers() []Rider
+	// Mount makes the Rider passed ride the vehicle, taking the first seat that is free. False is returned if the
+	// rider could not mount the vehicle, for example because all seats are taken.
+	Mount(r Rider) bool
+	// Dismount makes the Rider passed stop riding the vehicle. Nothing happens if the rider is not riding it.
+	Dismount(r Rider)
+	// SeatOffset returns the offset from the position of the vehicle of the seat that the Rider passed occupies.
+	// False is returned if the rider is not riding the vehicle.
+	SeatOffset(r Rider) (mgl64.Vec3, bool)
+}
+
+// DrivableVehicle is a Vehicle that is controlled by the input of its driver, such as a boat.
+type DrivableVehicle interface {
+	Vehicle
+	// Drive passes the input of the driver passed to the vehicle. Drive is called every time the driver sends
+	// input, which is typically every tick.
+	Drive(driver Rider, input VehicleInput)
+}
+
+// VehicleInput is the input of the driver of a DrivableVehicle.
+type VehicleInput struct {
+	// Forward is the forward movement input of the driver, ranging from -1 (backwards) to 1 (forwards).
+	Forward float64
+	// Strafe is the sideways movement input of the driver, ranging from -1 (right) to 1 (left).
+	Strafe float64
+	// Jumping specifies if the driver is holding the jump button.
+	Jumping bool
+	// Rotation is the rotation of the driver.
+	Rotation cube.Rotation
+}
+
+// Rider is an Entity that is able to ride a Vehicle.
+type Rider interface {
+	Entity
+	// Vehicle returns the Vehicle that the rider is currently riding. False is returned if the rider is not
+	// riding a vehicle.
+	Vehicle() (Vehicle, bool)
+	// SetVehicle sets the Vehicle ridden by the rider, or nil if the rider dismounted. It is called by the
+	// Vehicle when the rider mounts or dismounts it and should not be called directly: Vehicle.Mount and
+	// Vehicle.Dismount should be used instead.
+	SetVehicle(v Vehicle)
+	// MoveWithVehicle moves the rider to the position passed, being the position of its seat on the Vehicle it
+	// rides. It is called by the Vehicle every time it moves.
+	MoveWithVehicle(pos mgl64.Vec3)
+}
+
+// showLinks shows the links between the Entity passed and the vehicle it rides and the riders that ride it to a
+// viewer.
+func showLinks(e Entity, viewer Viewer) {
+	if r, ok := e.(Rider); ok {
+		if v, ok := r.Vehicle(); ok {
+			passengers := v.Passengers()
+			viewer.ViewEntityMount(r, v, len(passengers) > 0 && passengers[0] == r)
+		}
+	}
+	if v, ok := e.(Vehicle); ok {
+		for i, r := range v.Passengers() {
+			viewer.ViewEntityMount(r, v, i == 0)
+		}
+	}
+}
@@ -31,6 +31,8 @@ type Viewer interface {
 	ViewEntityTeleport(e Entity, pos mgl64.Vec3)
 	// ViewFurnaceUpdate updates a furnace for the associated session based on previous times.
 	ViewFurnaceUpdate(prevCookTime, cookTime, prevRemainingFuelTime, remainingFuelTime, prevMaxFuelTime, maxFuelTime time.Duration)
+	// ViewBrewingUpdate updates a brewing stand for the associated session based on previous times and fuel.
+	ViewBrewingUpdate(prevBrewTime, brewTime time.Duration, prevFuelAmount, fuelAmount, prevFuelTotal, fuelTotal int32)
 	// ViewChunk views the chunk passed at a particular position. It is called for every chunk loaded using
 	// the world.Loader.
 	ViewChunk(pos ChunkPos, c *chunk.Chunk, blockEntities map[cube.Pos]Block)
@@ -47,6 +49,11 @@ type Viewer interface {
 	// ViewEntityState views the current state of an entity. It is called whenever an entity changes its
 	// physical appearance, for example when sprinting.
 	ViewEntityState(e Entity)
+	// ViewEntityMount views an entity mounting a vehicle. If driver is true, the entity is the driver of the
+	// vehicle.
+	ViewEntityMount(e, vehicle Entity, driver bool)
+	// ViewEntityDismount views an entity dismounting a vehicle.
+	ViewEntityDismount(e, vehicle Entity)
 	// ViewEntityAnimation starts viewing an animation performed by an entity. The animation has to be from a resource pack.
 	ViewEntityAnimation(e Entity, animationName string)
 	// ViewParticle views a particle spawned at a given position in the world. It is called when a particle,
@@ -89,6 +96,8 @@ func (NopViewer) ViewEntityItems(Entity)                                     {}
 func (NopViewer) ViewEntityArmour(Entity)                                    {}
 func (NopViewer) ViewEntityAction(Entity, EntityAction)                      {}
 func (NopViewer) ViewEntityState(Entity)                                     {}
+func (NopViewer) ViewEntityMount(Entity, Entity, bool)                       {}
+func (NopViewer) ViewEntityDismount(Entity, Entity)                          {}
 func (NopViewer) ViewEntityAnimation(Entity, string)                         {}
 func (NopViewer) ViewParticle(mgl64.Vec3, Particle)                          {}
 func (NopViewer) ViewSound(mgl64.Vec3, Sound)                                {}
@@ -100,3 +109,4 @@ func (NopViewer) ViewWorldSpawn(cube.Pos)                                    {}
 func (NopViewer) ViewWeather(bool, bool)                                     {}
 func (NopViewer) ViewFurnaceUpdate(time.Duration, time.Duration, time.Duration, time.Duration, time.Duration, time.Duration) {
 }
+func (NopViewer) ViewBrewingUpdate(time.Duration, time.Duration, int32, int32, int32, int32) {}
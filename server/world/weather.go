@@ -172,12 +172,130 @@ func (w weather) tickLightning() {
 	}
 }
 
+// tickPrecipitation iterates over all loaded chunks in the World, selecting a random column in each one with a 1/16
+// chance. If it is snowing at the top of the column, exposed water is frozen into ice or a layer of snow is placed
+// on top of the column.
+func (w weather) tickPrecipitation() {
+	w.w.chunkMu.Lock()
+	positions := make([]cube.Pos, 0, len(w.w.chunks)/16)
+	for pos := range w.w.chunks {
+		if w.w.r.Intn(16) == 0 {
+			v := w.w.r.Int31()
+			positions = append(positions, cube.Pos{int(pos[0]<<4 + v&0xf), 0, int(pos[1]<<4 + (v>>8)&0xf)})
+		}
+	}
+	w.w.chunkMu.Unlock()
+
+	for _, pos := range positions {
+		pos[1] = w.w.HighestBlock(pos[0], pos[2])
+		if !w.freeze(pos) {
+			w.snow(pos.Side(cube.FaceUp))
+		}
+	}
+}
+
+// freeze attempts to freeze the water source block at the position passed into ice. Water is only frozen if it is
+// snowing at the position, if the water is not surrounded by water on all sides and if there is no bright light
+// source nearby.
+func (w weather) freeze(pos cube.Pos) bool {
+	liq, ok := w.w.Block(pos).(Liquid)
+	if !ok || liq.LiquidType() != "water" || liq.LiquidDepth() != 8 || liq.LiquidFalling() {
+		return false
+	}
+	if !w.SnowingAt(pos) || w.w.BlockLight(pos) >= 10 {
+		return false
+	}
+	exposed := false
+	for _, face := range cube.HorizontalFaces() {
+		if l, ok := w.w.Block(pos.Side(face)).(Liquid); !ok || l.LiquidType() != "water" {
+			exposed = true
+			break
+		}
+	}
+	if !exposed {
+		return false
+	}
+	ice, ok := BlockByName("minecraft:ice", nil)
+	if !ok {
+		return false
+	}
+	w.w.SetBlock(pos, ice, nil)
+	return true
+}
+
+// snow attempts to place a layer of snow at the position passed. Snow is only placed if it is snowing at the
+// position, if the position is empty, if the block below has a solid top face and if there is no bright light
+// source nearby.
+func (w weather) snow(pos cube.Pos) {
+	if !w.SnowingAt(pos) || w.w.BlockLight(pos) >= 10 {
+		return
+	}
+	if w.w.Block(pos) != air() {
+		return
+	}
+	below := pos.Side(cube.FaceDown)
+	if !w.w.Block(below).Model().FaceSolid(below, cube.FaceUp, w.w) {
+		return
+	}
+	if _, ok := w.w.Liquid(pos); ok {
+		return
+	}
+	snow, ok := BlockByName("minecraft:snow_layer", map[string]any{"height": int32(0), "covered_bit": uint8(0)})
+	if !ok {
+		return
+	}
+	w.w.SetBlock(pos, snow, nil)
+}
+
 // strikeLightning attempts to strike lightning in the world at a specific ChunkPos. The final position is influenced by
 // living entities that might be near the lightning strike. If there is no rain at the final position selected, the
 // lightning strike will fail.
 func (w weather) strikeLightning(c ChunkPos) {
 	if pos := w.lightningPosition(c); w.ThunderingAt(cube.PosFromVec3(pos)) {
 		w.w.AddEntity(w.w.conf.Entities.conf.Lightning(pos))
+		w.w.EmitGameEvent(pos, GameEventLightningStrike(), nil)
+		w.deoxidise(cube.PosFromVec3(pos).Side(cube.FaceDown))
+	}
+}
+
+// deoxidise removes all oxidation from the Oxidisable block struck by lightning at the position passed, if any.
+// Oxidation is then removed one stage at a time from Oxidisable blocks found in a few random walks from the
+// position struck.
+func (w weather) deoxidise(pos cube.Pos) {
+	if _, ok := w.w.Block(pos).(Oxidisable); !ok {
+		return
+	}
+	w.deoxidiseAt(pos, true)
+	for i := w.w.r.Intn(3) + 3; i > 0; i-- {
+		walk := pos
+		for j := w.w.r.Intn(8) + 1; j > 0; j-- {
+			next := walk.Add(cube.Pos{w.w.r.Intn(3) - 1, w.w.r.Intn(3) - 1, w.w.r.Intn(3) - 1})
+			if !w.deoxidiseAt(next, false) {
+				break
+			}
+			walk = next
+		}
+	}
+}
+
+// deoxidiseAt removes one stage of oxidation from the block at the position passed, or all oxidation if fully is
+// true. False is returned if no oxidation could be removed.
+func (w weather) deoxidiseAt(pos cube.Pos, fully bool) bool {
+	o, ok := w.w.Block(pos).(Oxidisable)
+	if !ok {
+		return false
+	}
+	deoxidised := false
+	for {
+		b, ok := o.Deoxidise()
+		if !ok {
+			return deoxidised
+		}
+		w.w.SetBlock(pos, b, nil)
+		deoxidised = true
+		if o, ok = b.(Oxidisable); !ok || !fully {
+			return true
+		}
 	}
 }
 
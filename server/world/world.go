@@ -62,6 +62,12 @@ type World struct {
 	// and the entry will be removed from the map.
 	scheduledUpdates map[cube.Pos]int64
 	neighbourUpdates []neighbourUpdate
+	// redstoneUpdates holds a set of positions at which the redstone power received may have changed since the
+	// last tick.
+	redstoneUpdates map[cube.Pos]struct{}
+	// sleepTicks is the amount of consecutive ticks during which enough Sleepers in the World were asleep to skip
+	// the night. It is only accessed while ticking the World.
+	sleepTicks int
 
 	viewersMu sync.Mutex
 	viewers   map[*Loader]Viewer
@@ -240,13 +246,10 @@ func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
 	c := w.chunk(chunkPosFromBlockPos(pos))
 
 	rid := BlockRuntimeID(b)
-
-	var before uint32
-	if rid != airRID && !opts.DisableLiquidDisplacement {
-		before = c.Block(x, y, z, 0)
-	}
+	before := c.Block(x, y, z, 0)
 
 	c.modified = true
+	c.revision++
 	c.SetBlock(x, y, z, 0, rid)
 	if nbtBlocks[rid] {
 		c.BlockEntities[pos] = b
@@ -290,9 +293,28 @@ func (w *World) SetBlock(pos cube.Pos, b Block, opts *SetOpts) {
 
 	if !opts.DisableBlockUpdates {
 		w.doBlockUpdatesAround(pos)
+		if conductorBlocks[rid] || conductorBlocks[before] {
+			w.doRedstoneUpdatesAround(pos)
+		}
 	}
 }
 
+// BlockRevision returns the revision of the blocks in the chunk at the position passed. The revision is
+// increased every time a block or liquid in the chunk is changed, so that data derived from the blocks in the
+// chunk, such as paths of entities, can be invalidated when it changes. If the chunk is not loaded, 0 is
+// returned.
+func (w *World) BlockRevision(pos ChunkPos) uint64 {
+	if w == nil {
+		return 0
+	}
+	c, ok := w.chunkFromCache(pos)
+	if !ok {
+		return 0
+	}
+	defer c.Unlock()
+	return c.revision
+}
+
 // SetBiome sets the biome at the position passed. If a chunk is not yet loaded at that position, the chunk is
 // first loaded or generated if it could not be found in the world save.
 func (w *World) SetBiome(pos cube.Pos, b Biome) {
@@ -388,6 +410,7 @@ func (w *World) BuildStructure(pos cube.Pos, s Structure) {
 			}
 			c.SetBlock(0, 0, 0, 0, c.Block(0, 0, 0, 0)) // Make sure the heightmap is recalculated.
 			c.modified = true
+			c.revision++
 
 			// After setting all blocks of the structure within a single chunk, we show the new chunk to all
 			// viewers once, and unlock it.
@@ -468,6 +491,7 @@ func (w *World) SetLiquid(pos cube.Pos, b Liquid) {
 		}
 	}
 	c.modified = true
+	c.revision++
 	c.Unlock()
 
 	w.doBlockUpdatesAround(pos)
@@ -566,6 +590,19 @@ func (w *World) SkyLight(pos cube.Pos) uint8 {
 	return c.SkyLight(uint8(pos[0]), int16(pos[1]), uint8(pos[2]))
 }
 
+// BlockLight returns the block light level at the position passed. This light level is only influenced by
+// blocks that emit light, such as torches or glowstone, and not by the sky. The light value, similarly to
+// Light, is a value in the range 0-15.
+func (w *World) BlockLight(pos cube.Pos) uint8 {
+	if w == nil || pos[1] < w.Range()[0] || pos[1] > w.Range()[1] {
+		// Fast way out.
+		return 0
+	}
+	c := w.chunk(chunkPosFromBlockPos(pos))
+	defer c.Unlock()
+	return c.BlockLight(uint8(pos[0]), int16(pos[1]), uint8(pos[2]))
+}
+
 // Time returns the current time of the world. The time is incremented every 1/20th of a second, unless
 // World.StopTime() is called.
 func (w *World) Time() int {
@@ -882,6 +919,50 @@ func (w *World) SetTickRange(v int) {
 	w.set.TickRange = int32(v)
 }
 
+// PlayersSleepingPercentage returns the percentage of Sleepers in the World that must be sleeping for the night to
+// be skipped.
+func (w *World) PlayersSleepingPercentage() int {
+	if w == nil {
+		return 100
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return int(w.set.PlayersSleepingPercentage)
+}
+
+// SetPlayersSleepingPercentage sets the percentage of Sleepers in the World that must be sleeping for the night to
+// be skipped. Setting it to 0 makes a single sleeping Sleeper enough to skip the night, while a value above 100
+// prevents the night from being skipped altogether.
+func (w *World) SetPlayersSleepingPercentage(v int) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.PlayersSleepingPercentage = int32(v)
+}
+
+// MobSpawning checks if mobs spawn naturally in the World.
+func (w *World) MobSpawning() bool {
+	if w == nil {
+		return false
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	return w.set.MobSpawning
+}
+
+// SetMobSpawning specifies if mobs should spawn naturally in the World. Mobs that were already spawned are not
+// removed when mob spawning is disabled.
+func (w *World) SetMobSpawning(v bool) {
+	if w == nil {
+		return
+	}
+	w.set.Lock()
+	defer w.set.Unlock()
+	w.set.MobSpawning = v
+}
+
 // tickRange returns the tick range around each Viewer.
 func (w *World) tickRange() int {
 	w.set.Lock()
@@ -955,6 +1036,85 @@ func (w *World) doBlockUpdatesAround(pos cube.Pos) {
 	w.updateMu.Unlock()
 }
 
+// doRedstoneUpdatesAround schedules redstone updates for all blocks within two blocks of the position passed, so
+// that blocks powered through a full block next to a Conductor are updated too.
+func (w *World) doRedstoneUpdatesAround(pos cube.Pos) {
+	if w == nil || pos.OutOfBounds(w.Range()) {
+		return
+	}
+	r := w.Range()
+
+	w.updateMu.Lock()
+	defer w.updateMu.Unlock()
+	w.redstoneUpdates[pos] = struct{}{}
+	pos.Neighbours(func(neighbour cube.Pos) {
+		w.redstoneUpdates[neighbour] = struct{}{}
+		neighbour.Neighbours(func(neighbour cube.Pos) {
+			w.redstoneUpdates[neighbour] = struct{}{}
+		}, r)
+	}, r)
+}
+
+// RedstonePower returns the highest redstone power, ranging from 0 to 15, that the block at the position passed
+// receives from any of its neighbours. Power is received directly from a neighbouring Conductor, or from a full,
+// opaque block that is strongly powered by a Conductor next to it.
+func (w *World) RedstonePower(pos cube.Pos) int {
+	if w == nil || pos.OutOfBounds(w.Range()) {
+		return 0
+	}
+	power := 0
+	for _, face := range cube.Faces() {
+		if p := w.EmittedRedstonePower(pos.Side(face), face.Opposite()); p > power {
+			power = p
+			if power >= 15 {
+				break
+			}
+		}
+	}
+	return power
+}
+
+// EmittedRedstonePower returns the redstone power, ranging from 0 to 15, that the block at the position passed
+// emits into the block on its side face.
+func (w *World) EmittedRedstonePower(pos cube.Pos, face cube.Face) int {
+	if w == nil || pos.OutOfBounds(w.Range()) {
+		return 0
+	}
+	b := w.Block(pos)
+	if c, ok := b.(Conductor); ok {
+		return c.WeakPower(pos, face, w)
+	}
+	if !w.conductive(pos, b) {
+		return 0
+	}
+	power := 0
+	for _, f := range cube.Faces() {
+		if f == face {
+			continue
+		}
+		side := pos.Side(f)
+		if c, ok := w.Block(side).(Conductor); ok {
+			power = max(power, c.StrongPower(side, f.Opposite(), w))
+		}
+	}
+	return power
+}
+
+// conductive checks if the block passed, placed at the position passed, passes on redstone power it is strongly
+// powered with. Only full blocks that do not let through any light are conductive.
+func (w *World) conductive(pos cube.Pos, b Block) bool {
+	if diffuser, ok := b.(lightDiffuser); ok && diffuser.LightDiffusionLevel() < 15 {
+		return false
+	}
+	m := b.Model()
+	for _, face := range cube.Faces() {
+		if !m.FaceSolid(pos, face, w) {
+			return false
+		}
+	}
+	return true
+}
+
 // neighbourUpdate represents a position that needs to be updated because of a neighbour that changed.
 type neighbourUpdate struct {
 	pos, neighbour cube.Pos
@@ -1157,6 +1317,7 @@ func showEntity(e Entity, viewer Viewer) {
 	viewer.ViewEntity(e)
 	viewer.ViewEntityItems(e)
 	viewer.ViewEntityArmour(e)
+	showLinks(e, viewer)
 }
 
 // chunk reads a chunk from the position passed. If a chunk at that position is not yet loaded, the chunk is
@@ -1359,6 +1520,8 @@ func (w *World) chunkCacheJanitor() {
 type Column struct {
 	sync.Mutex
 	modified bool
+	// revision is increased every time a block or liquid in the chunk is changed.
+	revision uint64
 
 	*chunk.Chunk
 	Entities      []Entity